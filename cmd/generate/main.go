@@ -4,15 +4,41 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"os"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/framework"
+
+	"github.com/hashicorp/terraform-provider-vault/codegen"
 )
 
 var pathToOpenAPIDoc = flag.String("openapi-doc", "", "path/to/openapi.json")
 
 func main() {
-	// TODO: revisit resource and data generation strategy after v3 release
-	os.Stderr.WriteString("resource generation has been disabled, " +
-		"please manually update previously generated resources\n")
-	os.Exit(1)
+	flag.Parse()
+
+	logger := hclog.Default()
+	if *pathToOpenAPIDoc == "" {
+		logger.Error("'openapi-doc' is required")
+		os.Exit(1)
+	}
+
+	docBytes, err := os.ReadFile(*pathToOpenAPIDoc)
+	if err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	doc := &framework.OASDocument{}
+	if err := json.Unmarshal(docBytes, doc); err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	if err := codegen.Run(logger, doc.Paths); err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
 }
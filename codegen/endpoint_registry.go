@@ -3,96 +3,32 @@
 
 package codegen
 
-import "github.com/hashicorp/vault/sdk/framework"
+import (
+	"github.com/hashicorp/vault/sdk/framework"
+)
 
 // endpointRegistry is a registry of all the endpoints we'd
 // like to have generated, along with the type of template
 // we should use.
 // IMPORTANT NOTE: To support high quality, only add one
 // endpoint per PR.
+//
+// The transform endpoints that used to be listed here were generated as
+// SDKv2 code and are now maintained by hand in the vault package, so they
+// must not be generated again.
 var endpointRegistry = map[string]*additionalInfo{
-	"/transform/alphabet/{name}": {
-		Type: tfTypeResource,
-	},
-	"/transform/decode/{role_name}": {
-		Type: tfTypeDataSource,
-		AdditionalParameters: []templatableParam{
-			{
-				OASParameter: &framework.OASParameter{
-					Name:        "decoded_value",
-					Description: "The result of decoding a value.",
-					Schema: &framework.OASSchema{
-						Type:         "string",
-						DisplayAttrs: &framework.DisplayAttributes{},
-					},
-				},
-				Computed: true,
-			},
-			{
-				OASParameter: &framework.OASParameter{
-					Name:        "batch_results",
-					Description: "The result of decoding batch_input.",
-					Schema: &framework.OASSchema{
-						Type: "array",
-						Items: &framework.OASSchema{
-							Type: "object",
-						},
-						DisplayAttrs: &framework.DisplayAttributes{},
-					},
-				},
-				Computed: true,
-			},
-		},
-	},
-	"/transform/encode/{role_name}": {
-		Type: tfTypeDataSource,
-		AdditionalParameters: []templatableParam{
-			{
-				OASParameter: &framework.OASParameter{
-					Name:        "encoded_value",
-					Description: "The result of encoding a value.",
-					Schema: &framework.OASSchema{
-						Type:         "string",
-						DisplayAttrs: &framework.DisplayAttributes{},
-					},
-				},
-				Computed: true,
-			},
-			{
-				OASParameter: &framework.OASParameter{
-					Name:        "batch_results",
-					Description: "The result of encoding batch_input.",
-					Schema: &framework.OASSchema{
-						Type: "array",
-						Items: &framework.OASSchema{
-							Type: "object",
-						},
-						DisplayAttrs: &framework.DisplayAttributes{},
-					},
-				},
-				Computed: true,
-			},
-		},
-	},
-	"/transform/role/{name}": {
-		Type: tfTypeResource,
-	},
-	"/transform/template/{name}": {
-		Type: tfTypeResource,
-	},
-	"/transform/transformation/{name}": {
-		Type: tfTypeResource,
+	"/transit/random": {
+		Type: tfTypeEphemeralResource,
 		AdditionalParameters: []templatableParam{
 			{
 				OASParameter: &framework.OASParameter{
-					Name:        "templates",
-					Description: "Templates configured for transformation.",
+					Name:        "random_bytes",
+					Description: "The random bytes, encoded in the requested format.",
 					Schema: &framework.OASSchema{
-						Type: "array",
-						Items: &framework.OASSchema{
-							Type: "string",
+						Type: "string",
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
 						},
-						DisplayAttrs: &framework.DisplayAttributes{},
 					},
 				},
 				Computed: true,
@@ -108,11 +44,13 @@ const (
 	tfTypeUnset tfType = iota
 	tfTypeDataSource
 	tfTypeResource
+	tfTypeEphemeralResource
 )
 
 // DocType returns the type of documentation that should be generated:
 // - d: data-source
 // - r: resource
+// - ephemeral-resources: ephemeral resource
 // This is in accordance with the Terraform Registries *legacy* naming scheme.
 // TODO: Migrate to updated registry documentation file structure.
 func (t tfType) DocType() string {
@@ -121,6 +59,8 @@ func (t tfType) DocType() string {
 		return "d"
 	case tfTypeResource:
 		return "r"
+	case tfTypeEphemeralResource:
+		return "ephemeral-resources"
 	}
 	return "unset"
 }
@@ -131,6 +71,8 @@ func (t tfType) String() string {
 		return "datasource"
 	case tfTypeResource:
 		return "resource"
+	case tfTypeEphemeralResource:
+		return "ephemeralresource"
 	}
 	return "unset"
 }
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
@@ -21,6 +23,19 @@ import (
 // the "vault" directory, which is at "drwxrwxr-x".
 const generatedDirPerms os.FileMode = 0o775
 
+const (
+	// modulePath is the Go module path of this repository.
+	modulePath = "github.com/hashicorp/terraform-provider-vault"
+
+	// generatedPackageRoot is the directory, relative to the repository root,
+	// that holds all generated code.
+	generatedPackageRoot = "internal/vault/generated"
+
+	// registryFilePath is the file, relative to the repository root, that
+	// registers all generated code with the provider.
+	registryFilePath = "internal/provider/fwprovider/provider_generated.go"
+)
+
 var errUnsupported = errors.New("code and doc generation for this item is unsupported")
 
 // Run accepts a map of endpoint paths and generates both code and documentation
@@ -37,9 +52,18 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 		logger:          logger,
 		templateHandler: h,
 	}
+	// Sort the endpoints so the registry is generated in a stable order.
+	endpoints := make([]string, 0, len(endpointRegistry))
+	for endpoint := range endpointRegistry {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
 	createdCount := 0
 	skippedCount := 0
-	for endpoint, addedInfo := range endpointRegistry {
+	var generated []string
+	for _, endpoint := range endpoints {
+		addedInfo := endpointRegistry[endpoint]
 		if err := fCreator.GenerateCode(endpoint, paths[endpoint], addedInfo); err != nil {
 			if err == errUnsupported {
 				logger.Warn(fmt.Sprintf("couldn't generate %s, continuing", endpoint))
@@ -49,6 +73,7 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 		}
 		logger.Info(fmt.Sprintf("generated %s for %s", addedInfo.Type.String(), endpoint))
 		createdCount++
+		generated = append(generated, endpoint)
 
 		created, err := fCreator.GenerateDoc(endpoint, paths[endpoint], addedInfo)
 		if err != nil {
@@ -61,6 +86,12 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 			skippedCount++
 		}
 	}
+	if err := fCreator.GenerateRegistry(generated); err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("generated provider registry for %d endpoints", len(generated)))
+	createdCount++
+
 	logger.Info(fmt.Sprintf("generated %d files", createdCount))
 	logger.Info(fmt.Sprintf("skipped generating %d docs because they already existed", skippedCount))
 	return nil
@@ -75,15 +106,42 @@ type fileCreator struct {
 // other objects. Unexported methods may be available to other code in this package,
 // but they're not intended to be used by anything but the fileCreator.
 func (c *fileCreator) GenerateCode(endpoint string, endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) error {
+	if endpointInfo == nil {
+		// The endpoint isn't in the OpenAPI document.
+		return errUnsupported
+	}
 	pathToFile, err := codeFilePath(addedInfo.Type, endpoint)
 	if err != nil {
 		return err
 	}
-	tmplType := templateTypeResource
-	if addedInfo.Type == tfTypeDataSource {
-		tmplType = templateTypeDataSource
+	return c.writeFile(pathToFile, codeTemplateType(addedInfo.Type), endpoint, endpointInfo, addedInfo)
+}
+
+// codeTemplateType returns the template used to generate code for the type.
+func codeTemplateType(tfTp tfType) templateType {
+	switch tfTp {
+	case tfTypeDataSource:
+		return templateTypeDataSource
+	case tfTypeEphemeralResource:
+		return templateTypeEphemeralResource
 	}
-	return c.writeFile(pathToFile, tmplType, endpoint, endpointInfo, addedInfo)
+	return templateTypeResource
+}
+
+// GenerateRegistry is exported to indicate it's intended to be directly used.
+// It registers the code for the given endpoints, which must have already been
+// generated by GenerateCode, with the Terraform Plugin Framework provider.
+func (c *fileCreator) GenerateRegistry(endpoints []string) error {
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		return err
+	}
+	wr, closer, err := c.createFileWriter(filepath.Join(repoRoot, registryFilePath))
+	if err != nil {
+		return err
+	}
+	defer closer()
+	return c.templateHandler.WriteRegistry(wr, endpoints)
 }
 
 // GenerateDoc is exported to indicate it's intended to be directly used.
@@ -148,11 +206,11 @@ func (c *fileCreator) createFileWriter(pathToFile string) (wr *bufio.Writer, clo
 }
 
 /*
-codeFilePath creates a directory structure inside the "generated" folder that's
-intended to make it easy to find the file for each endpoint in Vault, even if
-we eventually cover all >500 of them and add tests.
+codeFilePath creates a directory structure inside the "internal/vault/generated"
+folder that's intended to make it easy to find the file for each endpoint in Vault,
+even if we eventually cover all >500 of them and add tests.
 
-	terraform-provider-vault/generated$ tree
+	terraform-provider-vault/internal/vault/generated$ tree
 	.
 	├── datasources
 	│   └── transform
@@ -160,6 +218,11 @@ we eventually cover all >500 of them and add tests.
 	│       │   └── role_name.go
 	│       └── encode
 	│           └── role_name.go
+	├── ephemeralresources
+	│   └── transit
+	│       └── datakey
+	│           └── plaintext
+	│               └── name.go
 	└── resources
 		└── transform
 			├── alphabet
@@ -181,41 +244,32 @@ func codeFilePath(tfTp tfType, endpoint string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	path := filepath.Join(repoRoot, "generated", filename)
+	path := filepath.Join(repoRoot, filepath.FromSlash(generatedPackageRoot), filename)
 	return stripCurlyBraces(path), nil
 }
 
-/*
-docFilePath creates a directory structure inside the "website/docs/generated" folder
-that's intended to make it easy to find the file for each endpoint in Vault, even if
-we eventually cover all >500 of them and add tests.
+// packageDir returns the directory, relative to the repository root, of the
+// Go package holding the generated code for the endpoint.
+// Example:
+//
+//	endpoint: /transform/role/{name}
+//	dir: internal/vault/generated/resources/transform/role
+func packageDir(tfTp tfType, endpoint string) string {
+	dir := path.Join(generatedPackageRoot, tfTp.String()+"s", path.Dir(endpoint))
+	return stripCurlyBraces(dir)
+}
 
-	terraform-provider-vault/website/docs/generated$ tree
-	.
-	├── datasources
-	│   └── transform
-	│       ├── decode
-	│       │   └── role_name.md
-	│       └── encode
-	│           └── role_name.md
-	└── resources
-		└── transform
-			├── alphabet
-			│   └── name.md
-			├── alphabet.md
-			├── role
-			│   └── name.md
-			├── role.md
-			├── template
-			│   └── name.md
-			├── template.md
-			├── transformation
-			│   └── name.md
-			└── transformation.md
-*/
+// docFilePath returns the path of the doc for the endpoint, which is named
+// after the Terraform type generated for it so that the registry can find it.
+// Example:
+//
+//	endpoint: /transform/alphabet/{name}
+//	doc: website/docs/r/transform_alphabet.html.md
+//
+//	endpoint: /transit/hash/{urlalgorithm}
+//	doc: website/docs/d/transit_hash_urlalgorithm.html.md
 func docFilePath(tfTp tfType, endpoint string) (string, error) {
-	endpoint = normalizeDocEndpoint(endpoint)
-	filename := fmt.Sprintf("%s/%s.html.md", tfTp.DocType(), endpoint)
+	filename := fmt.Sprintf("%s/%s.html.md", tfTp.DocType(), typeName(endpoint))
 	repoRoot, err := getRepoRoot()
 	if err != nil {
 		return "", err
@@ -223,28 +277,6 @@ func docFilePath(tfTp tfType, endpoint string) (string, error) {
 	return filepath.Join(repoRoot, "website", "docs", filename), nil
 }
 
-// normalizeDocEndpoint changes the raw endpoint into the format we expect for
-// using in generated documentation structure on registry.terraform.io.
-// Example:
-//
-//	endpoint: /transform/alphabet/{name}
-//	normalized: transform_alphabet
-//
-//	endpoint: /transform/decode/{role_name}
-//	normalized: transform_decode
-//
-//	endpoint: /transform/encode/{role_name}
-//	normalized: transform_encode
-func normalizeDocEndpoint(endpoint string) string {
-	endpoint = stripCurlyBraces(endpoint)
-	endpoint = strings.TrimRight(endpoint, "name")
-	endpoint = strings.TrimRight(endpoint, "role_")
-	endpoint = strings.TrimRight(endpoint, "/")
-	endpoint = strings.ReplaceAll(endpoint, "/", "_")
-	endpoint = strings.TrimLeft(endpoint, "_")
-	return endpoint
-}
-
 // stripCurlyBraces converts a path like
 // "generated/resources/transform-transformation-{name}.go"
 // to "generated/resources/transform-transformation-name.go".
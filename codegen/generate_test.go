@@ -4,7 +4,16 @@
 package codegen
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/framework"
 )
 
 func TestCodeFilePath(t *testing.T) {
@@ -19,28 +28,28 @@ func TestCodeFilePath(t *testing.T) {
 	}{
 		{
 			input:                      "/database/roles",
-			expectedDataSourceFilePath: "/generated/datasources/database/roles.go",
-			expectedResourceFilePath:   "/generated/resources/database/roles.go",
+			expectedDataSourceFilePath: "/internal/vault/generated/datasources/database/roles.go",
+			expectedResourceFilePath:   "/internal/vault/generated/resources/database/roles.go",
 		},
 		{
 			input:                      "/database/roles/{name}",
-			expectedDataSourceFilePath: "/generated/datasources/database/roles/name.go",
-			expectedResourceFilePath:   "/generated/resources/database/roles/name.go",
+			expectedDataSourceFilePath: "/internal/vault/generated/datasources/database/roles/name.go",
+			expectedResourceFilePath:   "/internal/vault/generated/resources/database/roles/name.go",
 		},
 		{
 			input:                      "/auth/userpass/users/{username}/password",
-			expectedDataSourceFilePath: "/generated/datasources/auth/userpass/users/username/password.go",
-			expectedResourceFilePath:   "/generated/resources/auth/userpass/users/username/password.go",
+			expectedDataSourceFilePath: "/internal/vault/generated/datasources/auth/userpass/users/username/password.go",
+			expectedResourceFilePath:   "/internal/vault/generated/resources/auth/userpass/users/username/password.go",
 		},
 		{
 			input:                      "/auth/userpass/users/{username}/policies",
-			expectedDataSourceFilePath: "/generated/datasources/auth/userpass/users/username/policies.go",
-			expectedResourceFilePath:   "/generated/resources/auth/userpass/users/username/policies.go",
+			expectedDataSourceFilePath: "/internal/vault/generated/datasources/auth/userpass/users/username/policies.go",
+			expectedResourceFilePath:   "/internal/vault/generated/resources/auth/userpass/users/username/policies.go",
 		},
 		{
 			input:                      "/transit/export/{type}/{name}/{version}",
-			expectedDataSourceFilePath: "/generated/datasources/transit/export/type/name/version.go",
-			expectedResourceFilePath:   "/generated/resources/transit/export/type/name/version.go",
+			expectedDataSourceFilePath: "/internal/vault/generated/datasources/transit/export/type/name/version.go",
+			expectedResourceFilePath:   "/internal/vault/generated/resources/transit/export/type/name/version.go",
 		},
 	}
 	for _, testCase := range testCases {
@@ -92,6 +101,21 @@ func TestDocFilePath(t *testing.T) {
 			expectedDataSourceFilePath: "/website/docs/d/transit_export_type.html.md",
 			expectedResourceFilePath:   "/website/docs/r/transit_export_type.html.md",
 		},
+		{
+			input:                      "/transit/hash/{urlalgorithm}",
+			expectedDataSourceFilePath: "/website/docs/d/transit_hash_urlalgorithm.html.md",
+			expectedResourceFilePath:   "/website/docs/r/transit_hash_urlalgorithm.html.md",
+		},
+		{
+			input:                      "/transform/decode/{role_name}",
+			expectedDataSourceFilePath: "/website/docs/d/transform_decode.html.md",
+			expectedResourceFilePath:   "/website/docs/r/transform_decode.html.md",
+		},
+		{
+			input:                      "/transit/cache-config",
+			expectedDataSourceFilePath: "/website/docs/d/transit_cache_config.html.md",
+			expectedResourceFilePath:   "/website/docs/r/transit_cache_config.html.md",
+		},
 	}
 	for _, testCase := range testCases {
 		actualDataSourceDocPath, err := docFilePath(tfTypeDataSource, testCase.input)
@@ -142,3 +166,105 @@ func TestStripCurlyBraces(t *testing.T) {
 		})
 	}
 }
+
+func TestPackageDir(t *testing.T) {
+	testCases := []struct {
+		tfType   tfType
+		input    string
+		expected string
+	}{
+		{
+			tfType:   tfTypeResource,
+			input:    "/transform/role/{name}",
+			expected: "internal/vault/generated/resources/transform/role",
+		},
+		{
+			tfType:   tfTypeDataSource,
+			input:    "/transform/decode/{role_name}",
+			expected: "internal/vault/generated/datasources/transform/decode",
+		},
+		{
+			tfType:   tfTypeEphemeralResource,
+			input:    "/transit/datakey/{plaintext}/{name}",
+			expected: "internal/vault/generated/ephemeralresources/transit/datakey/plaintext",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			actual := packageDir(testCase.tfType, testCase.input)
+			if actual != testCase.expected {
+				t.Fatalf("expected %q but received %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+// TestGeneratedCode checks that the code committed for every endpoint in the
+// registry, and the provider registry itself, match what "make generate"
+// produces from the templates and "testdata/openapi.json". The generated code
+// is built along with the rest of the provider.
+func TestGeneratedCode(t *testing.T) {
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	docBytes, err := os.ReadFile(filepath.Join(repoRoot, "testdata", "openapi.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := &framework.OASDocument{}
+	if err := json.Unmarshal(docBytes, doc); err != nil {
+		t.Fatal(err)
+	}
+	h, err := newTemplateHandler(hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoints := make([]string, 0, len(endpointRegistry))
+	for endpoint := range endpointRegistry {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	if len(endpoints) == 0 {
+		t.Fatal("expected at least one endpoint in the registry")
+	}
+
+	for _, endpoint := range endpoints {
+		t.Run(endpoint, func(t *testing.T) {
+			endpointInfo, ok := doc.Paths[endpoint]
+			if !ok {
+				t.Fatalf("%s is not in the OpenAPI document", endpoint)
+			}
+			addedInfo := endpointRegistry[endpoint]
+			pathToFile, err := codeFilePath(addedInfo.Type, endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGenerated(t, pathToFile, func(wr io.Writer) error {
+				return h.Write(wr, codeTemplateType(addedInfo.Type), endpoint, endpointInfo, addedInfo)
+			})
+		})
+	}
+	t.Run("registry", func(t *testing.T) {
+		assertGenerated(t, filepath.Join(repoRoot, registryFilePath), func(wr io.Writer) error {
+			return h.WriteRegistry(wr, endpoints)
+		})
+	})
+}
+
+func assertGenerated(t *testing.T, pathToFile string, write func(io.Writer) error) {
+	t.Helper()
+
+	expected, err := os.ReadFile(pathToFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual := &bytes.Buffer{}
+	if err := write(actual); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, actual.Bytes()) {
+		t.Fatalf("%s is out of date, run \"make generate\"", pathToFile)
+	}
+}
@@ -4,6 +4,7 @@
 package codegen
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -17,14 +18,17 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/vault/sdk/framework"
+	"golang.org/x/tools/imports"
 )
 
 var (
 	// templateRegistry holds templates for each type of file.
 	templateRegistry = map[templateType]string{
-		templateTypeDataSource: "/codegen/templates/datasource.go.tpl",
-		templateTypeDoc:        "/codegen/templates/doc.go.tpl",
-		templateTypeResource:   "/codegen/templates/resource.go.tpl",
+		templateTypeDataSource:        "/codegen/templates/datasource.go.tpl",
+		templateTypeDoc:               "/codegen/templates/doc.go.tpl",
+		templateTypeEphemeralResource: "/codegen/templates/ephemeral.go.tpl",
+		templateTypeRegistry:          "/codegen/templates/registry.go.tpl",
+		templateTypeResource:          "/codegen/templates/resource.go.tpl",
 	}
 
 	// These are the types of fields that OpenAPI 3 has that we support
@@ -35,6 +39,16 @@ var (
 		"integer",
 		"string",
 	}
+
+	// These are the fields that every generated resource, data source and
+	// ephemeral resource already defines, so they can't be parameters.
+	reservedParamNames = []string{
+		"cluster",
+		"id",
+		"mount",
+		"mount_id",
+		"namespace",
+	}
 )
 
 func newTemplateHandler(logger hclog.Logger) (*templateHandler, error) {
@@ -87,7 +101,55 @@ func (h *templateHandler) Write(wr io.Writer, tmplTp templateType, endpoint stri
 		}
 		h.templatableEndpoints[endpoint] = templatable
 	}
-	return h.templates[tmplTp].Execute(wr, templatable)
+	if tmplTp == templateTypeDoc {
+		return h.templates[tmplTp].Execute(wr, templatable)
+	}
+	return h.executeCode(wr, tmplTp, templatable)
+}
+
+// WriteRegistry generates the file that registers all of the given
+// endpoints with the Terraform Plugin Framework provider. The endpoints
+// must have previously been passed to Write.
+func (h *templateHandler) WriteRegistry(wr io.Writer, endpoints []string) error {
+	registry := &templatableRegistry{}
+	seenImports := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		templatable, ok := h.templatableEndpoints[endpoint]
+		if !ok {
+			return fmt.Errorf("no templatable data found for %s", endpoint)
+		}
+		if !seenImports[templatable.PackagePath] {
+			seenImports[templatable.PackagePath] = true
+			registry.Imports = append(registry.Imports, templatableImport{
+				Alias: templatable.Alias,
+				Path:  templatable.PackagePath,
+			})
+		}
+		switch templatable.Type {
+		case tfTypeDataSource:
+			registry.DataSources = append(registry.DataSources, templatable)
+		case tfTypeEphemeralResource:
+			registry.EphemeralResources = append(registry.EphemeralResources, templatable)
+		default:
+			registry.Resources = append(registry.Resources, templatable)
+		}
+	}
+	return h.executeCode(wr, templateTypeRegistry, registry)
+}
+
+// executeCode executes a Go code template and formats the result, removing
+// any imports the template declared but that the generated code doesn't use.
+func (h *templateHandler) executeCode(wr io.Writer, tmplTp templateType, data interface{}) error {
+	b := &bytes.Buffer{}
+	if err := h.templates[tmplTp].Execute(b, data); err != nil {
+		return err
+	}
+	formatted, err := imports.Process("", b.Bytes(), nil)
+	if err != nil {
+		return errwrap.Wrapf("error formatting "+tmplTp.String()+": {{err}}", err)
+	}
+	_, err = wr.Write(formatted)
+	return err
 }
 
 // toTemplatable does a bunch of work to format the given data into a
@@ -101,6 +163,7 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 	// This is used to differentiate generated variable or function names
 	// so they don't collide with the other ones in the same package.
 	tmplName := format(path.Base(endpoint))
+	pkgDir := packageDir(addedInfo.Type, endpoint)
 	t := &templatableEndpoint{
 		Endpoint:                endpoint,
 		DirName:                 format(path.Base(filepath.Dir(endpoint))),
@@ -110,6 +173,9 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 		SupportsRead:            endpointInfo.Get != nil,
 		SupportsWrite:           endpointInfo.Post != nil,
 		SupportsDelete:          endpointInfo.Delete != nil,
+		Type:                    addedInfo.Type,
+		PackagePath:             path.Join(modulePath, pkgDir),
+		Alias:                   format(strings.ReplaceAll(strings.TrimPrefix(pkgDir, generatedPackageRoot+"/"), "/", "_")),
 	}
 	if err := t.Validate(); err != nil {
 		return nil, errwrap.Wrapf("failed to validate templatable data for "+endpoint+": {{err}}", err)
@@ -182,6 +248,79 @@ type templatableParam struct {
 	Computed    bool
 }
 
+// GoName returns the name of the parameter's field in generated model structs.
+func (p templatableParam) GoName() string {
+	return strings.Title(format(p.Name))
+}
+
+// AttrType returns the Terraform Plugin Framework schema attribute type for
+// the parameter. The resource, data source and ephemeral schema packages all
+// use the same names, so it works with each of them.
+func (p templatableParam) AttrType() string {
+	switch p.Schema.Type {
+	case "boolean":
+		return "schema.BoolAttribute"
+	case "integer":
+		return "schema.Int64Attribute"
+	case "array":
+		return "schema.ListAttribute"
+	}
+	return "schema.StringAttribute"
+}
+
+// ModelType returns the type of the parameter in Terraform model structs.
+func (p templatableParam) ModelType() string {
+	switch p.Schema.Type {
+	case "boolean":
+		return "types.Bool"
+	case "integer":
+		return "types.Int64"
+	case "array":
+		return "types.List"
+	}
+	return "types.String"
+}
+
+// APIType returns the type of the parameter in Vault API model structs.
+func (p templatableParam) APIType() string {
+	switch p.Schema.Type {
+	case "boolean":
+		return "bool"
+	case "integer":
+		return "int64"
+	case "array":
+		if p.Schema.Items.Type == "object" {
+			return "[]map[string]string"
+		}
+		return "[]string"
+	}
+	return "string"
+}
+
+// ElemType returns the element type of list parameters, and an empty string
+// for all others.
+func (p templatableParam) ElemType() string {
+	if p.Schema.Type != "array" {
+		return ""
+	}
+	if p.Schema.Items.Type == "object" {
+		return "types.MapType{ElemType: types.StringType}"
+	}
+	return "types.StringType"
+}
+
+// ValueFunc returns the function used to convert the parameter's API value
+// to its model value. It is not used for list parameters.
+func (p templatableParam) ValueFunc() string {
+	return p.ModelType() + "Value"
+}
+
+// ValueMethod returns the method used to convert the parameter's model value
+// to its API value. It is not used for list parameters.
+func (p templatableParam) ValueMethod() string {
+	return "Value" + strings.TrimPrefix(p.ModelType(), "types.")
+}
+
 func toTemplatableParam(param framework.OASParameter, isPathParameter bool) templatableParam {
 	ptrToParam := &param
 	if ptrToParam.Schema == nil {
@@ -211,6 +350,86 @@ type templatableEndpoint struct {
 	SupportsRead            bool
 	SupportsWrite           bool
 	SupportsDelete          bool
+
+	// Type is the type of Terraform code generated for the endpoint.
+	Type tfType
+
+	// PackagePath is the Go import path of the generated code, and Alias
+	// is the name it's imported as by the provider.
+	PackagePath string
+	Alias       string
+}
+
+// TypeName returns the name of the generated resource, data source or
+// ephemeral resource, without the provider prefix.
+func (e *templatableEndpoint) TypeName() string {
+	return typeName(e.Endpoint)
+}
+
+// typeName converts the endpoint into the name of its Terraform type, without
+// the provider prefix. A trailing name path parameter is dropped, since it
+// identifies an instance of the type.
+// Example:
+//
+//	endpoint: /transform/role/{name}
+//	type name: transform_role
+//
+//	endpoint: /transit/keys/{name}/rotate
+//	type name: transit_keys_name_rotate
+func typeName(endpoint string) string {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	endpoint = strings.TrimSuffix(endpoint, "/{name}")
+	endpoint = strings.TrimSuffix(endpoint, "/{role_name}")
+	endpoint = stripCurlyBraces(endpoint)
+	endpoint = strings.Trim(endpoint, "/")
+	endpoint = strings.ReplaceAll(endpoint, "/", "_")
+	return strings.ReplaceAll(endpoint, "-", "_")
+}
+
+// HasListParameters returns true if any of the endpoint's parameters are lists.
+func (e *templatableEndpoint) HasListParameters() bool {
+	for _, param := range e.Parameters {
+		if param.ElemType() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasComputedParameters returns true if any of the endpoint's parameters are
+// computed from the Vault response.
+func (e *templatableEndpoint) HasComputedParameters() bool {
+	for _, param := range e.Parameters {
+		if param.Computed {
+			return true
+		}
+	}
+	return false
+}
+
+// HasComputedListParameters returns true if any of the endpoint's computed
+// parameters are lists.
+func (e *templatableEndpoint) HasComputedListParameters() bool {
+	for _, param := range e.Parameters {
+		if param.Computed && param.ElemType() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// templatableRegistry holds every endpoint generated in a single run, so
+// that they can all be registered with the provider.
+type templatableRegistry struct {
+	Imports            []templatableImport
+	Resources          []*templatableEndpoint
+	DataSources        []*templatableEndpoint
+	EphemeralResources []*templatableEndpoint
+}
+
+type templatableImport struct {
+	Alias string
+	Path  string
 }
 
 func (e *templatableEndpoint) Validate() error {
@@ -239,6 +458,11 @@ func (e *templatableEndpoint) Validate() error {
 }
 
 func validateParameter(parameter templatableParam) error {
+	for _, reservedName := range reservedParamNames {
+		if parameter.Name == reservedName {
+			return fmt.Errorf("parameter name %s is reserved", parameter.Name)
+		}
+	}
 	for _, supportedType := range supportedParamTypes {
 		if parameter.Schema.Type == supportedType {
 			if parameter.Schema.Type != "array" {
@@ -265,7 +489,9 @@ func validateParameter(parameter templatableParam) error {
 func format(field string) string {
 	field = strings.ToLower(field)
 	field = stripCurlyBraces(field)
-	subFields := strings.Split(field, "_")
+	subFields := strings.FieldsFunc(field, func(c rune) bool {
+		return c == '_' || c == '-'
+	})
 	result := ""
 	for i, subField := range subFields {
 		if i == 0 {
@@ -284,6 +510,8 @@ const (
 	templateTypeDataSource
 	templateTypeResource
 	templateTypeDoc
+	templateTypeEphemeralResource
+	templateTypeRegistry
 )
 
 func (t templateType) String() string {
@@ -294,6 +522,10 @@ func (t templateType) String() string {
 		return "resource"
 	case templateTypeDoc:
 		return "doc"
+	case templateTypeEphemeralResource:
+		return "ephemeral"
+	case templateTypeRegistry:
+		return "registry"
 	}
 	return "unset"
 }
@@ -25,7 +25,24 @@ resource "vault_jwt_auth_backend" "example" {
 ## Argument Reference
 
 The following arguments are supported:
-* `path` - (Required) Path to where the back-end is mounted within Vault.
+* `namespace` - (Optional) The namespace to provision the resource in.
+  The value should not contain leading or trailing forward slashes.
+  The `namespace` is always relative to the provider's configured [namespace](/docs/providers/vault/index.html#namespace).
+  *Available only for Vault Enterprise*.
+* `mount` - (Required) Path to where the back-end is mounted within Vault.
 {{- range .Parameters }}
+{{- if not .Computed }}
 * `{{ .Name }}` - {{ if .Required }}(Required){{ else }}(Optional){{ end }} {{ .Description }}
 {{- end }}
+{{- end }}
+{{- if .HasComputedParameters }}
+
+## Attributes Reference
+
+In addition to the arguments above, the following attributes are exported:
+{{- range .Parameters }}
+{{- if .Computed }}
+* `{{ .Name }}` - {{ .Description }}
+{{- end }}
+{{- end }}
+{{- end }}
@@ -5,6 +5,7 @@ package codegen
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -163,6 +164,26 @@ func TestValidate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			testName: "reserved param name",
+			input: &templatableEndpoint{
+				Endpoint:                "foo",
+				DirName:                 "foo",
+				UpperCaseDifferentiator: "foo",
+				LowerCaseDifferentiator: "foo",
+				Parameters: []templatableParam{
+					{
+						OASParameter: &framework.OASParameter{
+							Name: "namespace",
+							Schema: &framework.OASSchema{
+								Type: "string",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			testName: "array of strings param",
 			input: &templatableEndpoint{
@@ -497,11 +518,43 @@ func TestParseParameters(t *testing.T) {
 	}
 }
 
-func TestTemplateHandler(t *testing.T) {
-	h, err := newTemplateHandler(hclog.Default())
-	if err != nil {
-		t.Fatal(err)
+func TestTypeName(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "/transform/role/{name}",
+			expected: "transform_role",
+		},
+		{
+			input:    "/transform/decode/{role_name}",
+			expected: "transform_decode",
+		},
+		{
+			input:    "/transit/keys/{name}/rotate",
+			expected: "transit_keys_name_rotate",
+		},
+		{
+			input:    "/transit/cache-config",
+			expected: "transit_cache_config",
+		},
+		{
+			input:    "/kv/config/",
+			expected: "kv_config",
+		},
 	}
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			actual := typeName(testCase.input)
+			if actual != testCase.expected {
+				t.Fatalf("expected %q but received %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateHandler(t *testing.T) {
 	endpointInfo := &framework.OASPathItem{}
 	if err := json.Unmarshal([]byte(`{
 	"description": "Read, write, and delete roles.",
@@ -569,19 +622,78 @@ func TestTemplateHandler(t *testing.T) {
 }`), endpointInfo); err != nil {
 		t.Fatal(err)
 	}
-	b := &strings.Builder{}
-	if err := h.Write(b, templateTypeResource, "/transform/role/{name}", endpointInfo, &additionalInfo{
-		Type: tfTypeResource,
-	}); err != nil {
-		t.Fatal(err)
+	testCases := []struct {
+		tmplType templateType
+		tfType   tfType
+		expected []string
+	}{
+		{
+			tmplType: templateTypeResource,
+			tfType:   tfTypeResource,
+			expected: []string{
+				"package role",
+				"base.ResourceWithConfigure",
+				"func NewNameResource() resource.Resource",
+				`resp.TypeName = req.ProviderTypeName + "_transform_role"`,
+				"maps.Copy(attrs, mount.GetMountPathAttributes())",
+				"Transformations []string `json:\"transformations\"`",
+			},
+		},
+		{
+			tmplType: templateTypeDataSource,
+			tfType:   tfTypeDataSource,
+			expected: []string{
+				"package role",
+				"base.DataSourceWithConfigure",
+				"func NewNameDataSource() datasource.DataSource",
+			},
+		},
+		{
+			tmplType: templateTypeEphemeralResource,
+			tfType:   tfTypeEphemeralResource,
+			expected: []string{
+				"package role",
+				"base.EphemeralResourceWithConfigure",
+				"var NewNameEphemeralResource = func() ephemeral.EphemeralResource",
+			},
+		},
 	}
-	result := b.String()
+	for _, testCase := range testCases {
+		t.Run(testCase.tmplType.String(), func(t *testing.T) {
+			// Use a new handler each time, the templatable data is cached by endpoint.
+			h, err := newTemplateHandler(hclog.Default())
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := &strings.Builder{}
+			if err := h.Write(b, testCase.tmplType, "/transform/role/{name}", endpointInfo, &additionalInfo{
+				Type: testCase.tfType,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			result := b.String()
+
+			// We only spot check here because resources will be covered by their
+			// own tests fully testing validity. This test is mainly to make sure
+			// we're getting something that looks correct back rather than an empty
+			// string. The generated code is also run through gofmt, so it's at least
+			// syntactically valid.
+			for _, expected := range testCase.expected {
+				if !strings.Contains(result, expected) {
+					t.Fatalf("expected %q in result: %s", expected, result)
+				}
+			}
 
-	// We only spot check here because resources will be covered by their
-	// own tests fully testing validity. This test is mainly to make sure
-	// we're getting something that looks correct back rather than an empty
-	// string.
-	if !strings.Contains(result, "resourceNameExists") {
-		t.Fatalf("unexpected result: %s", result)
+			b = &strings.Builder{}
+			if err := h.WriteRegistry(b, []string{"/transform/role/{name}"}); err != nil {
+				t.Fatal(err)
+			}
+			registry := b.String()
+			expectedImport := fmt.Sprintf(`%ssTransformRole "github.com/hashicorp/terraform-provider-vault/internal/vault/generated/%ss/transform/role"`,
+				testCase.tfType.String(), testCase.tfType.String())
+			if !strings.Contains(registry, expectedImport) {
+				t.Fatalf("expected %q in registry: %s", expectedImport, registry)
+			}
+		})
 	}
 }
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// rateLimitMinBackoff is the backoff applied after a 429 response without
+	// a Retry-After header.
+	rateLimitMinBackoff = time.Second
+	// rateLimitMaxBackoff is the upper bound for any backoff.
+	rateLimitMaxBackoff = time.Minute
+	// rateLimitMinRate is the lowest rate that the limiter slows down to.
+	rateLimitMinRate = rate.Limit(1)
+)
+
+// RateLimiter limits the rate and concurrency of the requests made by a
+// TransportWrapper. A single RateLimiter is shared by all clients of a Vault
+// cluster, including namespaced clients, since Vault enforces its rate limit
+// quotas per cluster.
+//
+// The limiter adapts to the quotas enforced by Vault: on a 429 response, all
+// requests are paused until the time given by the Retry-After header. If a
+// request rate was configured, it is also halved, and each successful
+// response increases it again until the configured rate is reached. Without a
+// configured rate there is no rate to adapt, so the requests are only paused.
+type RateLimiter struct {
+	limiter *rate.Limiter
+	// limit is the configured rate limit.
+	limit rate.Limit
+	// sem limits the number of concurrent requests, if set.
+	sem chan struct{}
+
+	// backoffUntil is the time until all requests are paused.
+	backoffUntil time.Time
+	// backoff is the backoff applied after the next 429 without a Retry-After header.
+	backoff time.Duration
+	m       sync.Mutex
+}
+
+// NewRateLimiter returns a new RateLimiter that allows up to
+// requestsPerSecond requests, with bursts of up to burst requests, and at
+// most maxInFlight concurrent requests. A requestsPerSecond of zero means
+// that the request rate is unlimited, and a maxInFlight of zero means that
+// the number of concurrent requests is unlimited.
+func NewRateLimiter(requestsPerSecond float64, burst, maxInFlight int) *RateLimiter {
+	limit := rate.Inf
+	if requestsPerSecond > 0 {
+		limit = rate.Limit(requestsPerSecond)
+	}
+
+	if burst <= 0 {
+		burst = max(1, int(requestsPerSecond))
+	}
+
+	l := &RateLimiter{
+		limiter: rate.NewLimiter(limit, burst),
+		limit:   limit,
+		backoff: rateLimitMinBackoff,
+	}
+
+	if maxInFlight > 0 {
+		l.sem = make(chan struct{}, maxInFlight)
+	}
+
+	return l
+}
+
+// RoundTrip sends req with next, once the rate limit allows it.
+func (l *RateLimiter) RoundTrip(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+			defer func() { <-l.sem }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := next(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		l.throttle(resp.Header.Get("Retry-After"))
+	} else {
+		l.restore()
+	}
+
+	return resp, nil
+}
+
+// wait blocks until the request may be sent.
+func (l *RateLimiter) wait(ctx context.Context) error {
+	l.m.Lock()
+	delay := time.Until(l.backoffUntil)
+	l.m.Unlock()
+
+	if delay > 0 {
+		log.Printf("[DEBUG] Rate limited by Vault, delaying request for %s", delay)
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return l.limiter.Wait(ctx)
+}
+
+// throttle pauses all requests and halves the request rate, if one was
+// configured, after Vault responded with a 429.
+func (l *RateLimiter) throttle(retryAfter string) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	delay, ok := parseRetryAfter(retryAfter)
+	if !ok {
+		delay = l.backoff
+		l.backoff = min(2*l.backoff, rateLimitMaxBackoff)
+	}
+	delay = min(delay, rateLimitMaxBackoff)
+
+	if until := time.Now().Add(delay); until.After(l.backoffUntil) {
+		l.backoffUntil = until
+	}
+
+	if l.limit == rate.Inf {
+		log.Printf("[WARN] Rate limited by Vault, pausing requests for %s", delay)
+		return
+	}
+
+	newLimit := max(l.limiter.Limit()/2, rateLimitMinRate)
+	l.limiter.SetLimit(newLimit)
+
+	log.Printf("[WARN] Rate limited by Vault, pausing requests for %s and reducing the rate to %.2f requests per second",
+		delay, float64(newLimit))
+}
+
+// restore increases the request rate after a successful response, until the
+// configured rate is reached.
+func (l *RateLimiter) restore() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.backoff = rateLimitMinBackoff
+
+	current := l.limiter.Limit()
+	if current == l.limit {
+		return
+	}
+
+	l.limiter.SetLimit(min(current*1.1, l.limit))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
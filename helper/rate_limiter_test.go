@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimiter_MaxInFlight(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	opts := DefaultTransportOptions()
+	opts.RateLimiter = NewRateLimiter(0, 0, 2)
+	client := &http.Client{
+		Transport: NewTransport("Vault", http.DefaultTransport, opts),
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL + "/v1/secret/a")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if actual := maxInFlight.Load(); actual > 2 {
+		t.Errorf("expected at most 2 requests in flight, actual %d", actual)
+	}
+}
+
+func TestRateLimiter_TooManyRequests(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	l := NewRateLimiter(100, 10, 0)
+	opts := DefaultTransportOptions()
+	opts.RateLimiter = l
+	client := &http.Client{
+		Transport: NewTransport("Vault", http.DefaultTransport, opts),
+	}
+
+	do := func() int {
+		resp, err := client.Get(server.URL + "/v1/secret/a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if actual := do(); actual != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, actual %d", http.StatusTooManyRequests, actual)
+	}
+
+	if actual := l.limiter.Limit(); actual != rate.Limit(50) {
+		t.Errorf("expected the rate to be halved to 50, actual %v", actual)
+	}
+
+	start := time.Now()
+	if actual := do(); actual != http.StatusOK {
+		t.Fatalf("expected status %d, actual %d", http.StatusOK, actual)
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("expected the request to be delayed by Retry-After, elapsed %s", elapsed)
+	}
+
+	if actual := l.limiter.Limit(); actual <= rate.Limit(50) {
+		t.Errorf("expected the rate to increase after a successful request, actual %v", actual)
+	}
+
+	for range 10 {
+		do()
+	}
+
+	if actual := l.limiter.Limit(); actual != l.limit {
+		t.Errorf("expected the rate to be restored to %v, actual %v", l.limit, actual)
+	}
+}
+
+func TestRateLimiter_TooManyRequestsMaxInFlight(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	// only the concurrency is limited, so there is no rate to adapt
+	l := NewRateLimiter(0, 0, 2)
+	opts := DefaultTransportOptions()
+	opts.RateLimiter = l
+	client := &http.Client{
+		Transport: NewTransport("Vault", http.DefaultTransport, opts),
+	}
+
+	do := func() int {
+		resp, err := client.Get(server.URL + "/v1/secret/a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if actual := do(); actual != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, actual %d", http.StatusTooManyRequests, actual)
+	}
+
+	if actual := l.limiter.Limit(); actual != rate.Inf {
+		t.Errorf("expected the rate to remain unlimited, actual %v", actual)
+	}
+
+	start := time.Now()
+	if actual := do(); actual != http.StatusOK {
+		t.Fatalf("expected status %d, actual %d", http.StatusOK, actual)
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("expected the request to be delayed by Retry-After, elapsed %s", elapsed)
+	}
+
+	start = time.Now()
+	for range 20 {
+		do()
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the requests not to be rate limited, elapsed %s", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			name:   "empty",
+			value:  "",
+			wantOK: false,
+		},
+		{
+			name:   "seconds",
+			value:  "5",
+			want:   5 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "date-in-past",
+			value:  "Wed, 21 Oct 2015 07:28:00 GMT",
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "invalid",
+			value:  "soon",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("parseRetryAfter() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("parseRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// wrapTTLHeaderName is the header used to request response wrapping. The Vault
+// API package does not export it.
+const wrapTTLHeaderName = "X-Vault-Wrap-TTL"
+
+// Reads with the Cache-Control: no-cache header are never served from, or
+// stored in, the ReadCache. See DisableReadCache.
+const (
+	cacheControlHeaderName = "Cache-Control"
+	cacheControlNoCache    = "no-cache"
+)
+
+// ReadCache is a client-side cache of Vault read responses, used by the
+// TransportWrapper. A ReadCache lives as long as the provider instance it was
+// created for, so every Terraform run starts with an empty cache.
+//
+// Only GET requests, including LIST requests which the Vault API client sends
+// as GET requests with list=true, are cached. The cache only deduplicates
+// identical reads: identical reads that are in flight at the same time are
+// coalesced into a single request to Vault, and repeated reads are served
+// from the cache. LIST responses only hold the keys below a path, not the
+// data at each key, so the reads of sibling paths are not batched or served
+// from them: each sibling is read from Vault once per run. Responses are
+// cached per token and namespace, so a cached response is never served to a
+// client that could not have read it from Vault.
+//
+// Only successful responses are cached. Responses that carry a lease, auth or
+// wrapping information are never cached, since reading them again yields a
+// new secret. Not found responses are never cached either, so that reads
+// retried until an object is replicated or created see it once it exists.
+//
+// Any write invalidates the cached responses for the same path, for its
+// parent paths (e.g. LIST responses) and for its child paths in the same
+// namespace. A write to one of the KV v2 paths of a secret, e.g.
+// <mount>/data/<name> or <mount>/destroy/<name>, also invalidates the other
+// paths of the secret, e.g. <mount>/metadata/<name>. Writes that change
+// mounts, auth methods, policies or namespaces, e.g. to sys/mounts or
+// sys/policies/acl, can have side effects on arbitrary paths, so they
+// invalidate the whole cache.
+//
+// Reads that are polled for changes, or that have side effects, must bypass
+// the cache with DisableReadCache.
+type ReadCache struct {
+	entries  map[readCacheKey]*readCacheEntry
+	inflight map[readCacheKey]*readCacheCall
+	m        sync.Mutex
+}
+
+type readCacheKey struct {
+	token     string
+	namespace string
+	path      string
+	query     string
+}
+
+type readCacheEntry struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+type readCacheCall struct {
+	wg    sync.WaitGroup
+	entry *readCacheEntry
+}
+
+// NewReadCache returns a new, empty ReadCache.
+func NewReadCache() *ReadCache {
+	return &ReadCache{
+		entries:  make(map[readCacheKey]*readCacheEntry),
+		inflight: make(map[readCacheKey]*readCacheCall),
+	}
+}
+
+// RoundTrip serves req from the cache if possible, otherwise it is sent with
+// next.
+func (c *ReadCache) RoundTrip(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	key := newReadCacheKey(req)
+	if req.Method != http.MethodGet {
+		c.invalidate(key)
+		return next(req)
+	}
+
+	if req.Header.Get(wrapTTLHeaderName) != "" || req.Header.Get(cacheControlHeaderName) == cacheControlNoCache {
+		return next(req)
+	}
+
+	c.m.Lock()
+	if entry, ok := c.entries[key]; ok {
+		c.m.Unlock()
+		log.Printf("[DEBUG] Serving %s %s from the read cache", req.Method, req.URL.Path)
+		return entry.response(req), nil
+	}
+
+	if call, ok := c.inflight[key]; ok {
+		c.m.Unlock()
+		call.wg.Wait()
+		if call.entry != nil {
+			log.Printf("[DEBUG] Serving %s %s from a coalesced read", req.Method, req.URL.Path)
+			return call.entry.response(req), nil
+		}
+		// the response was not cacheable, so it cannot be shared
+		return next(req)
+	}
+
+	call := &readCacheCall{}
+	call.wg.Add(1)
+	c.inflight[key] = call
+	c.m.Unlock()
+
+	resp, err := next(req)
+	if err == nil {
+		call.entry, resp, err = newReadCacheEntry(req, resp)
+	}
+
+	c.m.Lock()
+	delete(c.inflight, key)
+	if call.entry != nil {
+		c.entries[key] = call.entry
+	}
+	c.m.Unlock()
+	call.wg.Done()
+
+	return resp, err
+}
+
+// invalidate removes all entries that may have been changed by a write to
+// the path of key.
+func (c *ReadCache) invalidate(key readCacheKey) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if invalidatesAll(key.path) {
+		clear(c.entries)
+		return
+	}
+
+	paths := kvV2AliasPaths(key.path)
+	for k := range c.entries {
+		if k.namespace != key.namespace {
+			continue
+		}
+
+		for _, p := range paths {
+			if k.path == p ||
+				strings.HasPrefix(p, k.path+"/") ||
+				strings.HasPrefix(k.path, p+"/") {
+				delete(c.entries, k)
+				break
+			}
+		}
+	}
+}
+
+// invalidatingSysPaths are the paths below sys/ whose writes change mounts,
+// auth methods, policies or namespaces. They can have side effects on
+// arbitrary paths, e.g. when a mount is tuned or moved.
+var invalidatingSysPaths = []string{
+	"mounts",
+	"auth",
+	"remount",
+	"policy",
+	"policies",
+	"namespaces",
+}
+
+// invalidatesAll returns true if a write to path must invalidate the whole
+// cache. Other writes below sys/, e.g. to sys/capabilities-self or
+// sys/wrapping/lookup, only invalidate the entries of their own path. The
+// path may be prefixed with a namespace.
+func invalidatesAll(path string) bool {
+	segments := strings.Split(path, "/")
+	i := slices.Index(segments, "sys")
+	if i == -1 || i == len(segments)-1 {
+		return false
+	}
+
+	return slices.Contains(invalidatingSysPaths, segments[i+1])
+}
+
+// kvV2Prefixes are the path segments below a KV v2 mount that address the
+// same secret.
+var kvV2Prefixes = []string{
+	"data",
+	"metadata",
+	"delete",
+	"undelete",
+	"destroy",
+	"subkeys",
+}
+
+// kvV2AliasPaths returns path along with all the paths that address the same
+// KV v2 secret as path, if it looks like a KV v2 path. The mount type is not
+// known, so a path that only looks like a KV v2 path invalidates more entries
+// than needed, which is harmless.
+func kvV2AliasPaths(path string) []string {
+	paths := []string{path}
+
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		if !slices.Contains(kvV2Prefixes, segments[i]) {
+			continue
+		}
+
+		mount := strings.Join(segments[:i], "/")
+		name := strings.Join(segments[i+1:], "/")
+		for _, prefix := range kvV2Prefixes {
+			if prefix == segments[i] {
+				continue
+			}
+			alias := mount + "/" + prefix
+			if name != "" {
+				alias += "/" + name
+			}
+			paths = append(paths, alias)
+		}
+	}
+
+	return paths
+}
+
+func newReadCacheKey(req *http.Request) readCacheKey {
+	var token string
+	if v := req.Header.Get(api.AuthHeaderName); v != "" {
+		sum := sha256.Sum256([]byte(v))
+		token = hex.EncodeToString(sum[:])
+	}
+
+	return readCacheKey{
+		token:     token,
+		namespace: strings.Trim(req.Header.Get(api.NamespaceHeaderName), "/"),
+		path:      strings.Trim(strings.TrimPrefix(req.URL.Path, "/v1/"), "/"),
+		query:     req.URL.Query().Encode(),
+	}
+}
+
+// newReadCacheEntry returns a cache entry for resp, or nil if resp cannot be
+// cached. The response body is consumed, so the returned response must be
+// used in place of resp.
+func newReadCacheEntry(req *http.Request, resp *http.Response) (*readCacheEntry, *http.Response, error) {
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if !cacheableSecret(body) {
+		return nil, resp, nil
+	}
+
+	entry := &readCacheEntry{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	}
+
+	return entry, entry.response(req), nil
+}
+
+// cacheableSecret returns true if the response body is safe to hand out
+// more than once.
+func cacheableSecret(body []byte) bool {
+	var secret struct {
+		LeaseID  string          `json:"lease_id"`
+		Auth     json.RawMessage `json:"auth"`
+		WrapInfo json.RawMessage `json:"wrap_info"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return false
+	}
+
+	isNull := func(v json.RawMessage) bool {
+		return len(v) == 0 || string(v) == "null"
+	}
+
+	return secret.LeaseID == "" && isNull(secret.Auth) && isNull(secret.WrapInfo)
+}
+
+func (e *readCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.statusCode),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// DisableReadCache returns a clone of client whose reads always bypass the
+// ReadCache. It must be used for reads that have side effects and for reads
+// that are polled for changes, e.g. while waiting for an operation to
+// complete.
+func DisableReadCache(client *api.Client) (*api.Client, error) {
+	clone, err := client.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	clone.AddHeader(cacheControlHeaderName, cacheControlNoCache)
+
+	return clone, nil
+}
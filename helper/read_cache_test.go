@@ -0,0 +1,324 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func TestReadCache(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/v1/secret/dynamic":
+			fmt.Fprint(w, `{"lease_id": "secret/dynamic/abc", "data": {}}`)
+		case "/v1/secret/missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": []}`)
+		case "/v1/secret/error":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"errors": ["internal error"]}`)
+		default:
+			fmt.Fprintf(w, `{"data": {"path": %q}}`, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	opts := DefaultTransportOptions()
+	opts.ReadCache = NewReadCache()
+	client := &http.Client{
+		Transport: NewTransport("Vault", http.DefaultTransport, opts),
+	}
+
+	do := func(t *testing.T, method, path string, header map[string]string) string {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	assertRequests := func(t *testing.T, expected int64) {
+		t.Helper()
+		if actual := requests.Swap(0); actual != expected {
+			t.Errorf("expected %d requests to Vault, actual %d", expected, actual)
+		}
+	}
+
+	token := map[string]string{api.AuthHeaderName: "token"}
+
+	tests := []struct {
+		name     string
+		requests func(t *testing.T)
+		expected int64
+	}{
+		{
+			name: "repeated-reads",
+			requests: func(t *testing.T) {
+				first := do(t, http.MethodGet, "/v1/secret/a", token)
+				second := do(t, http.MethodGet, "/v1/secret/a", token)
+				if first != second {
+					t.Errorf("expected cached response %q, actual %q", first, second)
+				}
+			},
+			expected: 1,
+		},
+		{
+			name: "repeated-lists",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret?list=true", token)
+				do(t, http.MethodGet, "/v1/secret?list=true", token)
+				do(t, http.MethodGet, "/v1/secret", token)
+			},
+			expected: 2,
+		},
+		{
+			name: "not-found-not-cached",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/missing", token)
+				do(t, http.MethodGet, "/v1/secret/missing", token)
+			},
+			expected: 2,
+		},
+		{
+			name: "errors-not-cached",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/error", token)
+				do(t, http.MethodGet, "/v1/secret/error", token)
+			},
+			expected: 2,
+		},
+		{
+			name: "leases-not-cached",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/dynamic", token)
+				do(t, http.MethodGet, "/v1/secret/dynamic", token)
+			},
+			expected: 2,
+		},
+		{
+			name: "wrapped-not-cached",
+			requests: func(t *testing.T) {
+				header := map[string]string{
+					api.AuthHeaderName: "token",
+					wrapTTLHeaderName:  "60s",
+				}
+				do(t, http.MethodGet, "/v1/secret/b", header)
+				do(t, http.MethodGet, "/v1/secret/b", header)
+			},
+			expected: 2,
+		},
+		{
+			name: "no-cache-not-cached",
+			requests: func(t *testing.T) {
+				header := map[string]string{
+					api.AuthHeaderName:     "token",
+					cacheControlHeaderName: cacheControlNoCache,
+				}
+				do(t, http.MethodGet, "/v1/secret/h", header)
+				do(t, http.MethodGet, "/v1/secret/h", header)
+				do(t, http.MethodGet, "/v1/secret/h", token)
+				do(t, http.MethodGet, "/v1/secret/h", token)
+			},
+			expected: 3,
+		},
+		{
+			name: "per-token-and-namespace",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/c", token)
+				do(t, http.MethodGet, "/v1/secret/c", map[string]string{
+					api.AuthHeaderName: "other",
+				})
+				do(t, http.MethodGet, "/v1/secret/c", map[string]string{
+					api.AuthHeaderName:      "token",
+					api.NamespaceHeaderName: "ns1",
+				})
+				do(t, http.MethodGet, "/v1/secret/c", map[string]string{
+					api.AuthHeaderName:      "token",
+					api.NamespaceHeaderName: "ns1/",
+				})
+			},
+			expected: 3,
+		},
+		{
+			name: "write-invalidates-path-and-parents",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/kv/d", token)
+				do(t, http.MethodGet, "/v1/kv?list=true", token)
+				do(t, http.MethodGet, "/v1/kv/d/e", token)
+				do(t, http.MethodGet, "/v1/other", token)
+				do(t, http.MethodPut, "/v1/kv/d", token)
+				do(t, http.MethodGet, "/v1/kv/d", token)
+				do(t, http.MethodGet, "/v1/kv?list=true", token)
+				do(t, http.MethodGet, "/v1/kv/d/e", token)
+				do(t, http.MethodGet, "/v1/other", token)
+			},
+			expected: 8,
+		},
+		{
+			name: "kv-v2-write-invalidates-aliases",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/kvv2/data/app/i", token)
+				do(t, http.MethodGet, "/v1/kvv2/metadata/app/i", token)
+				do(t, http.MethodGet, "/v1/kvv2/metadata/app?list=true", token)
+				do(t, http.MethodGet, "/v1/kvv2/data/app/j", token)
+				do(t, http.MethodPost, "/v1/kvv2/destroy/app/i", token)
+				do(t, http.MethodGet, "/v1/kvv2/data/app/i", token)
+				do(t, http.MethodGet, "/v1/kvv2/metadata/app/i", token)
+				do(t, http.MethodGet, "/v1/kvv2/metadata/app?list=true", token)
+				do(t, http.MethodGet, "/v1/kvv2/data/app/j", token)
+			},
+			expected: 8,
+		},
+		{
+			name: "write-other-namespace",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/f", token)
+				do(t, http.MethodDelete, "/v1/secret/f", map[string]string{
+					api.AuthHeaderName:      "token",
+					api.NamespaceHeaderName: "ns1",
+				})
+				do(t, http.MethodGet, "/v1/secret/f", token)
+			},
+			expected: 2,
+		},
+		{
+			name: "sys-write-invalidates-all",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/g", token)
+				do(t, http.MethodPost, "/v1/sys/mounts/secret/tune", token)
+				do(t, http.MethodGet, "/v1/secret/g", token)
+			},
+			expected: 3,
+		},
+		{
+			name: "sys-policy-write-invalidates-all",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/i", token)
+				do(t, http.MethodPut, "/v1/sys/policies/acl/test", token)
+				do(t, http.MethodGet, "/v1/secret/i", token)
+			},
+			expected: 3,
+		},
+		{
+			name: "namespaced-sys-write-invalidates-all",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/j", token)
+				do(t, http.MethodPost, "/v1/ns1/sys/auth/userpass", token)
+				do(t, http.MethodGet, "/v1/secret/j", token)
+			},
+			expected: 3,
+		},
+		{
+			name: "sys-read-only-posts",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/secret/k", token)
+				do(t, http.MethodPost, "/v1/sys/capabilities-self", token)
+				do(t, http.MethodPost, "/v1/sys/wrapping/lookup", token)
+				do(t, http.MethodGet, "/v1/secret/k", token)
+			},
+			expected: 3,
+		},
+		{
+			name: "sys-write-invalidates-same-path",
+			requests: func(t *testing.T) {
+				do(t, http.MethodGet, "/v1/sys/quotas/rate-limit/test", token)
+				do(t, http.MethodPost, "/v1/sys/quotas/rate-limit/test", token)
+				do(t, http.MethodGet, "/v1/sys/quotas/rate-limit/test", token)
+			},
+			expected: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.requests(t)
+			assertRequests(t, tt.expected)
+		})
+	}
+
+	t.Run("concurrent-reads", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				do(t, http.MethodGet, "/v1/secret/h", token)
+			}()
+		}
+		wg.Wait()
+		assertRequests(t, 1)
+	})
+}
+
+func TestKVV2AliasPaths(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{
+			path:     "secret/foo",
+			expected: []string{"secret/foo"},
+		},
+		{
+			path: "secret/data/foo/bar",
+			expected: []string{
+				"secret/data/foo/bar",
+				"secret/metadata/foo/bar",
+				"secret/delete/foo/bar",
+				"secret/undelete/foo/bar",
+				"secret/destroy/foo/bar",
+				"secret/subkeys/foo/bar",
+			},
+		},
+		{
+			path: "team/kv/undelete/foo",
+			expected: []string{
+				"team/kv/undelete/foo",
+				"team/kv/data/foo",
+				"team/kv/metadata/foo",
+				"team/kv/delete/foo",
+				"team/kv/destroy/foo",
+				"team/kv/subkeys/foo",
+			},
+		},
+		{
+			path:     "data",
+			expected: []string{"data"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if actual := kvV2AliasPaths(tt.path); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -44,6 +44,10 @@ type TransportOptions struct {
 	// LogResponseBody for all responses, ideally this would only be enabled for debug purposes,
 	// since the response body might contain secrets.
 	LogResponseBody bool
+	// ReadCache serves repeated reads from a client-side cache, if set.
+	ReadCache *ReadCache
+	// RateLimiter limits the rate and concurrency of requests, if set.
+	RateLimiter *RateLimiter
 }
 
 // DefaultTransportOptions for setting up the HTTP TransportWrapper wrapper.
@@ -91,6 +95,22 @@ func (t *TransportWrapper) SetTLSConfig(c *tls.Config) error {
 }
 
 func (t *TransportWrapper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.options.ReadCache != nil {
+		return t.options.ReadCache.RoundTrip(req, t.limitedRoundTrip)
+	}
+
+	return t.limitedRoundTrip(req)
+}
+
+func (t *TransportWrapper) limitedRoundTrip(req *http.Request) (*http.Response, error) {
+	if t.options.RateLimiter != nil {
+		return t.options.RateLimiter.RoundTrip(req, t.roundTrip)
+	}
+
+	return t.roundTrip(req)
+}
+
+func (t *TransportWrapper) roundTrip(req *http.Request) (*http.Response, error) {
 	transportID := uuid.New().String()
 	if logging.IsDebugOrHigher() {
 		var origHeaders http.Header
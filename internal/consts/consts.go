@@ -176,6 +176,7 @@ const (
 	FieldAuthLoginJWT                       = "auth_login_jwt"
 	FieldAuthLoginAzure                     = "auth_login_azure"
 	FieldAuthLoginTokenFile                 = "auth_login_token_file"
+	FieldAuthLoginAgent                     = "auth_login_agent"
 	FieldIAMHttpRequestMethod               = "iam_http_request_method"
 	FieldIAMRequestURL                      = "iam_request_url"
 	FieldIAMRequestBody                     = "iam_request_body"
@@ -206,6 +207,7 @@ const (
 	FieldServiceAccount                     = "service_account"
 	FieldAuthorization                      = "authorization"
 	FieldToken                              = "token"
+	FieldTokenName                          = "token_name"
 	FieldTokenTTL                           = "token_ttl"
 	FieldTokenReviewerJWT                   = "token_reviewer_jwt"
 	FieldTokenReviewerJWTWO                 = "token_reviewer_jwt_wo"
@@ -315,6 +317,71 @@ const (
 	FieldIdentityPolicies                   = "identity_policies"
 	FieldVaultVersionOverride               = "vault_version_override"
 	FieldSkipGetVaultVersion                = "skip_get_vault_version"
+	FieldEnableReadCache                    = "enable_read_cache"
+	FieldRenewToken                         = "renew_token"
+	FieldMaxRequestsPerSecond               = "max_requests_per_second"
+	FieldMaxRequestsBurst                   = "max_requests_burst"
+	FieldMaxConcurrentRequests              = "max_concurrent_requests"
+	FieldCluster                            = "cluster"
+	FieldTimeout                            = "timeout"
+	FieldDelta                              = "delta"
+	FieldUnified                            = "unified"
+	FieldCRL                                = "crl"
+	FieldCRLNumber                          = "crl_number"
+	FieldThisUpdate                         = "this_update"
+	FieldNextUpdate                         = "next_update"
+	FieldProducedAt                         = "produced_at"
+	FieldRevokedCertificates                = "revoked_certificates"
+	FieldRevokedSerialNumbers               = "revoked_serial_numbers"
+	FieldRevocationTime                     = "revocation_time"
+	FieldRevocationReason                   = "revocation_reason"
+	FieldRevokedAt                          = "revoked_at"
+	FieldStatus                             = "status"
+	FieldIP                                 = "ip"
+	FieldOTP                                = "otp"
+	FieldKey                                = "key"
+	FieldPrefix                             = "prefix"
+	FieldRecursive                          = "recursive"
+	FieldLeaseIDs                           = "lease_ids"
+	FieldSync                               = "sync"
+	FieldWrapTTL                            = "wrap_ttl"
+	FieldCreationPath                       = "creation_path"
+	FieldCreationTTL                        = "creation_ttl"
+	FieldGenerate                           = "generate"
+	FieldAccountName                        = "account_name"
+	FieldCode                               = "code"
+	FieldKeyWO                              = "key_wo"
+	FieldURLWO                              = "url_wo"
+	FieldBarcode                            = "barcode"
+	FieldCurrentVersion                     = "current_version"
+	FieldOldestVersion                      = "oldest_version"
+	FieldUpdatedTime                        = "updated_time"
+	FieldSecrets                            = "secrets"
+	FieldSecretsWO                          = "secrets_wo"
+	FieldPrune                              = "prune"
+	FieldCAS                                = "cas"
+	FieldReplicationType                    = "replication_type"
+	FieldMode                               = "mode"
+	FieldState                              = "state"
+	FieldClusterID                          = "cluster_id"
+	FieldPrimaryClusterAddr                 = "primary_cluster_addr"
+	FieldPrimaryAPIAddr                     = "primary_api_addr"
+	FieldCAFile                             = "ca_file"
+	FieldSecondaryID                        = "secondary_id"
+	FieldSecondaryPublicKey                 = "secondary_public_key"
+	FieldConnectionState                    = "connection_state"
+	FieldKnownSecondaries                   = "known_secondaries"
+	FieldKnownPrimaryClusterAddrs           = "known_primary_cluster_addrs"
+	FieldLastWAL                            = "last_wal"
+	FieldLastRemoteWAL                      = "last_remote_wal"
+	FieldMerkleRoot                         = "merkle_root"
+	FieldSecondaries                        = "secondaries"
+	FieldNodeID                             = "node_id"
+	FieldAPIAddress                         = "api_address"
+	FieldClusterAddress                     = "cluster_address"
+	FieldConnectionStatus                   = "connection_status"
+	FieldLastHeartbeat                      = "last_heartbeat"
+	FieldCapabilities                       = "capabilities"
 	FieldMemberEntityIDs                    = "member_entity_ids"
 	FieldMemberGroupIDs                     = "member_group_ids"
 	FieldExclusive                          = "exclusive"
@@ -621,6 +688,7 @@ const (
 	FieldPrivateKeyID                       = "private_key_id"
 	FieldTune                               = "tune"
 	FieldMaxRetries                         = "max_retries"
+	FieldMaxRetriesCCC                      = "max_retries_ccc"
 	FieldRetryDelay                         = "retry_delay"
 	FieldMaxRetryDelay                      = "max_retry_delay"
 	FieldSessionTags                        = "session_tags"
@@ -706,6 +774,16 @@ const (
 	FieldPlaintext                   = "plaintext"
 	FieldCiphertext                  = "ciphertext"
 	FieldNewCiphertext               = "new_ciphertext"
+	FieldNonce                       = "nonce"
+	FieldBits                        = "bits"
+	FieldBackup                      = "backup"
+	FieldBackupWO                    = "backup_wo"
+	FieldCiphertextWO                = "ciphertext_wo"
+	FieldKeyMaterialWO               = "key_material_wo"
+	FieldImportVersion               = "import_version"
+	FieldHashFunction                = "hash_function"
+	FieldAllowRotation               = "allow_rotation"
+	FieldForce                       = "force"
 	FieldBindSecretID                = "bind_secret_id"
 	FieldSecretIDBoundCIDRs          = "secret_id_bound_cidrs"
 	FieldSecretIDNumUses             = "secret_id_num_uses"
@@ -930,8 +1008,20 @@ const (
 		common environment variables
 	*/
 	EnvVarVaultNamespaceImport = "TERRAFORM_VAULT_NAMESPACE_IMPORT"
+	// EnvVarVaultClusterImport to import resources from a provider cluster
+	EnvVarVaultClusterImport = "TERRAFORM_VAULT_CLUSTER_IMPORT"
 	// EnvVarSkipChildToken to allow user from creating child tokens
 	EnvVarSkipChildToken = "TERRAFORM_VAULT_SKIP_CHILD_TOKEN"
+	// EnvVarEnableReadCache to enable the client-side read cache
+	EnvVarEnableReadCache = "TERRAFORM_VAULT_ENABLE_READ_CACHE"
+	// EnvVarRenewToken to enable renewal of the provider's token
+	EnvVarRenewToken = "TERRAFORM_VAULT_RENEW_TOKEN"
+	// EnvVarMaxRequestsPerSecond to limit the rate of requests to Vault
+	EnvVarMaxRequestsPerSecond = "TERRAFORM_VAULT_MAX_REQUESTS_PER_SECOND"
+	// EnvVarMaxRequestsBurst to set the burst size of the request rate limit
+	EnvVarMaxRequestsBurst = "TERRAFORM_VAULT_MAX_REQUESTS_BURST"
+	// EnvVarMaxConcurrentRequests to limit the number of concurrent requests to Vault
+	EnvVarMaxConcurrentRequests = "TERRAFORM_VAULT_MAX_CONCURRENT_REQUESTS"
 	// EnvVarUsername to get the username for the userpass auth method
 	EnvVarUsername = "TERRAFORM_VAULT_USERNAME"
 	// EnvVarPassword to get the password for the userpass auth method
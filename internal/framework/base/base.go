@@ -10,7 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,6 +28,15 @@ func (w *withMeta) Meta() *provider.ProviderMeta {
 	return w.meta
 }
 
+// tokenDiagnostics returns the warnings raised about the provider's token in
+// the background, see provider.ProviderMeta.TokenDiagnostics. The framework
+// configures resources, data sources, ephemeral resources and actions before
+// each operation, so returning them from Configure surfaces them along with
+// the operation's own diagnostics, like the SDKv2 resources do.
+func tokenDiagnostics(meta *provider.ProviderMeta) diag.Diagnostics {
+	return sdkv2Diagnostics(meta.TokenDiagnostics())
+}
+
 // ResourceWithConfigure is a structure to be embedded within a Resource that
 // implements the ResourceWithConfigure interface.
 type ResourceWithConfigure struct {
@@ -37,6 +48,7 @@ type ResourceWithConfigure struct {
 func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*provider.ProviderMeta); ok {
 		r.meta = v
+		response.Diagnostics.Append(tokenDiagnostics(v)...)
 	}
 }
 
@@ -45,8 +57,8 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 //
 // https://developer.hashicorp.com/terraform/plugin/framework/resources/import.
 //
-// This will ensure the Vault namespace is written to state if it is set in the
-// environment.
+// This will ensure the Vault namespace and the provider cluster are written to
+// state if they are set in the environment.
 // https://registry.terraform.io/providers/hashicorp/vault/latest/docs#namespace-support
 type WithImportByID struct{}
 
@@ -64,6 +76,25 @@ func (w *WithImportByID) ImportState(ctx context.Context, request resource.Impor
 			response.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	ImportClusterFromEnv(ctx, response)
+}
+
+// ImportClusterFromEnv writes the provider cluster to the imported state if it
+// is set in the environment. It is intended to be called from the ImportState
+// method of resources that do not embed WithImportByID.
+func ImportClusterFromEnv(ctx context.Context, response *resource.ImportStateResponse) {
+	cluster := os.Getenv(consts.EnvVarVaultClusterImport)
+	if cluster != "" {
+		tflog.Info(
+			ctx,
+			fmt.Sprintf("Environment variable %s set, attempting TF state import", consts.EnvVarVaultClusterImport),
+			map[string]any{consts.FieldCluster: cluster},
+		)
+		response.Diagnostics.Append(
+			response.State.SetAttribute(ctx, path.Root(consts.FieldCluster), cluster)...,
+		)
+	}
 }
 
 // DataSourceWithConfigure is a structure to be embedded within a DataSource
@@ -77,6 +108,7 @@ type DataSourceWithConfigure struct {
 func (d *DataSourceWithConfigure) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*provider.ProviderMeta); ok {
 		d.meta = v
+		response.Diagnostics.Append(tokenDiagnostics(v)...)
 	}
 }
 
@@ -91,5 +123,21 @@ type EphemeralResourceWithConfigure struct {
 func (r *EphemeralResourceWithConfigure) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*provider.ProviderMeta); ok {
 		r.meta = v
+		response.Diagnostics.Append(tokenDiagnostics(v)...)
+	}
+}
+
+// ActionWithConfigure is a structure to be embedded within an Action that
+// implements the ActionWithConfigure interface.
+type ActionWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Action type.
+func (a *ActionWithConfigure) Configure(_ context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*provider.ProviderMeta); ok {
+		a.meta = v
+		response.Diagnostics.Append(tokenDiagnostics(v)...)
 	}
 }
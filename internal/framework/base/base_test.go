@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func TestConfigure_tokenDiagnostics(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(meta any) diag.Diagnostics
+	}{
+		{
+			name: "resource",
+			configure: func(meta any) diag.Diagnostics {
+				var resp resource.ConfigureResponse
+				(&ResourceWithConfigure{}).Configure(context.Background(), resource.ConfigureRequest{ProviderData: meta}, &resp)
+				return resp.Diagnostics
+			},
+		},
+		{
+			name: "data-source",
+			configure: func(meta any) diag.Diagnostics {
+				var resp datasource.ConfigureResponse
+				(&DataSourceWithConfigure{}).Configure(context.Background(), datasource.ConfigureRequest{ProviderData: meta}, &resp)
+				return resp.Diagnostics
+			},
+		},
+		{
+			name: "ephemeral-resource",
+			configure: func(meta any) diag.Diagnostics {
+				var resp ephemeral.ConfigureResponse
+				(&EphemeralResourceWithConfigure{}).Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: meta}, &resp)
+				return resp.Diagnostics
+			},
+		},
+		{
+			name: "action",
+			configure: func(meta any) diag.Diagnostics {
+				var resp action.ConfigureResponse
+				(&ActionWithConfigure{}).Configure(context.Background(), action.ConfigureRequest{ProviderData: meta}, &resp)
+				return resp.Diagnostics
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diags := tt.configure(nil); len(diags) != 0 {
+				t.Errorf("expected no diagnostics before the provider is configured, actual %v", diags)
+			}
+
+			meta := &provider.ProviderMeta{}
+			meta.AddTokenWarning("summary", "detail")
+
+			diags := tt.configure(meta)
+			if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning || diags[0].Summary() != "summary" || diags[0].Detail() != "detail" {
+				t.Errorf("expected the token warning, actual %v", diags)
+			}
+
+			if diags := tt.configure(meta); len(diags) != 0 {
+				t.Errorf("expected the token warning to be returned once, actual %v", diags)
+			}
+		})
+	}
+}
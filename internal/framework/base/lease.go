@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// privateKeyLeaseData is the private data key of the lease of an ephemeral
+// resource.
+const privateKeyLeaseData = "lease_data"
+
+// EphemeralResourceWithLease is a structure to be embedded within an Ephemeral
+// Resource that reads a leased secret from Vault. The lease is renewed for as
+// long as Terraform uses the secret, and revoked once it no longer needs it.
+//
+// The Open method of the Ephemeral Resource must call SetLease.
+type EphemeralResourceWithLease struct {
+	EphemeralResourceWithConfigure
+}
+
+// leasePrivateData is the lease information stored in the private data of an
+// ephemeral resource.
+type leasePrivateData struct {
+	LeaseID   string `json:"lease_id"`
+	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
+}
+
+// SetLease stores the lease of secret in the private data of the ephemeral
+// resource, and schedules its renewal when the lease is renewable. It is a
+// no-op for secrets without a lease.
+func (r *EphemeralResourceWithLease) SetLease(ctx context.Context, resp *ephemeral.OpenResponse, secret *api.Secret, namespace, cluster string) {
+	if secret == nil || secret.LeaseID == "" {
+		return
+	}
+
+	b, err := json.Marshal(leasePrivateData{
+		LeaseID:   secret.LeaseID,
+		Namespace: namespace,
+		Cluster:   cluster,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to marshal private data", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyLeaseData, b)...)
+	resp.RenewAt = leaseRenewAt(secret)
+}
+
+// Renew renews the lease, and schedules the next renewal.
+func (r *EphemeralResourceWithLease) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	lease, c, diags := r.lease(ctx, req.Private.GetKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || lease == nil {
+		return
+	}
+
+	tflog.Debug(ctx, "Renewing lease", map[string]any{consts.FieldLeaseID: lease.LeaseID})
+	secret, err := c.Sys().RenewWithContext(ctx, lease.LeaseID, 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to renew lease",
+			fmt.Sprintf("Failed to renew lease %q: %s", lease.LeaseID, err),
+		)
+		return
+	}
+
+	resp.RenewAt = leaseRenewAt(secret)
+}
+
+// Close revokes the lease, so that the secret can no longer be used once
+// Terraform is done with it.
+func (r *EphemeralResourceWithLease) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	lease, c, diags := r.lease(ctx, req.Private.GetKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || lease == nil {
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking lease", map[string]any{consts.FieldLeaseID: lease.LeaseID})
+	if err := c.Sys().RevokeWithContext(ctx, lease.LeaseID); err != nil {
+		// the secret expires with its lease, so failing to revoke it must not
+		// fail the run.
+		resp.Diagnostics.AddWarning(
+			"Unable to revoke lease",
+			fmt.Sprintf("Failed to revoke lease %q: %s", lease.LeaseID, err),
+		)
+	}
+}
+
+// lease returns the lease stored in the private data, and a client for its
+// namespace and cluster. It returns a nil lease when no lease is stored.
+func (r *EphemeralResourceWithLease) lease(ctx context.Context, getKey func(context.Context, string) ([]byte, diag.Diagnostics)) (*leasePrivateData, *api.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	b, d := getKey(ctx, privateKeyLeaseData)
+	diags.Append(d...)
+	if diags.HasError() || len(b) == 0 {
+		return nil, nil, diags
+	}
+
+	var lease leasePrivateData
+	if err := json.Unmarshal(b, &lease); err != nil {
+		diags.AddError("Unable to unmarshal private data", err.Error())
+		return nil, nil, diags
+	}
+	if lease.LeaseID == "" {
+		return nil, nil, diags
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), lease.Namespace, lease.Cluster)
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return nil, nil, diags
+	}
+
+	return &lease, c, diags
+}
+
+// leaseRenewAt returns the time at which the lease of secret should be
+// renewed, at two thirds of its duration. It returns the zero time, meaning
+// never, for non-renewable leases.
+func leaseRenewAt(secret *api.Secret) time.Time {
+	if secret == nil || !secret.Renewable || secret.LeaseDuration <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(time.Duration(secret.LeaseDuration) * time.Second * 2 / 3)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// ListResourceWithSDKv2 is a structure to be embedded within a ListResource
+// whose managed resource is implemented with the SDKv2.
+//
+// The SDKv2 resource must be configured with provider.NewIdentity. Each
+// listed object is identified by the same ID that is used to import the
+// resource, so that `terraform query` can generate import blocks for it.
+type ListResourceWithSDKv2 struct {
+	ResourceWithConfigure
+
+	resource *schema.Resource
+}
+
+// ListResult describes a single object found by a ListResource.
+type ListResult struct {
+	// ID is the Terraform ID that the managed resource is imported by.
+	ID string
+	// DisplayName is a human-readable name for the object.
+	DisplayName string
+}
+
+// SetSDKv2Resource sets the SDKv2 managed resource that backs the list
+// resource. It is called by the provider when the list resource is created.
+func (r *ListResourceWithSDKv2) SetSDKv2Resource(res *schema.Resource) {
+	r.resource = res
+}
+
+// RawV5Schemas returns the schemas of the SDKv2 managed resource.
+func (r *ListResourceWithSDKv2) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	if r.resource == nil {
+		return
+	}
+
+	resp.ProtoV5Schema = r.resource.ProtoSchema(ctx)()
+	if f := r.resource.ProtoIdentitySchema(ctx); f != nil {
+		resp.ProtoV5IdentitySchema = f()
+	}
+}
+
+// ListResults streams the objects in results. If the request asks for the
+// resource to be included, the SDKv2 resource's Read function is called for
+// each object, exactly as it would be after `terraform import`.
+func (r *ListResourceWithSDKv2) ListResults(ctx context.Context, req list.ListRequest, namespace, cluster string, results []ListResult) iter.Seq[list.ListResult] {
+	if r.resource == nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Unconfigured list resource",
+			"The list resource has no managed resource to read the listed objects with. "+
+				"This is a bug in the provider, please report it to the provider developers.",
+		)
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for _, v := range results {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			result, ok := r.listResult(ctx, req, namespace, cluster, v)
+			if !ok {
+				continue
+			}
+
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
+
+func (r *ListResourceWithSDKv2) listResult(ctx context.Context, req list.ListRequest, namespace, cluster string, v ListResult) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = v.DisplayName
+
+	state := &terraform.InstanceState{
+		ID: v.ID,
+		Attributes: map[string]string{
+			consts.FieldID: v.ID,
+		},
+	}
+	if namespace != "" {
+		state.Attributes[consts.FieldNamespace] = namespace
+	}
+	if cluster != "" {
+		state.Attributes[consts.FieldCluster] = cluster
+	}
+
+	if req.IncludeResource {
+		tflog.Debug(ctx, "Reading listed resource", map[string]any{consts.FieldID: v.ID})
+		newState, diags := r.resource.RefreshWithoutUpgrade(ctx, state, r.Meta())
+		result.Diagnostics.Append(sdkv2Diagnostics(diags)...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if newState == nil {
+			// the object was deleted after it was listed
+			return result, false
+		}
+		state = newState
+	}
+
+	d := r.resource.Data(state)
+	if err := provider.SetIdentity(d); err != nil {
+		result.Diagnostics.AddError("Error setting resource identity", err.Error())
+		return result, true
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Error converting resource identity", err.Error())
+		return result, true
+	}
+	result.Identity.Raw = *identity
+
+	if req.IncludeResource {
+		resource, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Error converting resource state", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *resource
+	}
+
+	return result, true
+}
+
+func sdkv2Diagnostics(diags sdkdiag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+	for _, d := range diags {
+		if d.Severity == sdkdiag.Error {
+			result.AddError(d.Summary, d.Detail)
+		} else {
+			result.AddWarning(d.Summary, d.Detail)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func TestListResourceWithSDKv2_ListResults_unconfigured(t *testing.T) {
+	r := &ListResourceWithSDKv2{}
+
+	var count int
+	for result := range r.ListResults(context.Background(), list.ListRequest{}, "", "", []ListResult{{ID: "foo"}}) {
+		count++
+		if !result.Diagnostics.HasError() {
+			t.Fatalf("expected an error diagnostic, got %v", result.Diagnostics)
+		}
+	}
+
+	if count != 1 {
+		t.Fatalf("expected 1 result, got %d", count)
+	}
+}
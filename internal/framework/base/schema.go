@@ -6,7 +6,10 @@ package base
 import (
 	"fmt"
 
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +24,7 @@ import (
 // This struct should be embedded into all Terraform Plugin Framework Resources and Data Sources.
 type BaseModel struct {
 	Namespace types.String `tfsdk:"namespace"`
+	Cluster   types.String `tfsdk:"cluster"`
 }
 
 // BaseModelLegacy describes common fields for all Terraform resource
@@ -63,6 +67,14 @@ func MustAddBaseSchema(s *schema.Schema) {
 	mustAddSchema(s, baseSchema)
 }
 
+// MustAddBaseDataSourceSchema adds the schema fields that are required for all
+// data sources built with the TF Plugin Framework.
+//
+// This should be called from a data source's Schema() method.
+func MustAddBaseDataSourceSchema(s *datasourceschema.Schema) {
+	mustAddDataSourceSchema(s, baseDataSourceSchema)
+}
+
 // MustAddBaseEphemeralSchema adds the schema fields that are required for all net new
 // resources and data sources built with the TF Plugin Framework.
 //
@@ -71,6 +83,22 @@ func MustAddBaseEphemeralSchema(s *ephemeralschema.Schema) {
 	mustAddEphemeralSchema(s, baseEphemeralSchema)
 }
 
+// MustAddBaseListSchema adds the schema fields that are required for all
+// list resources.
+//
+// This should be called from a list resource's ListResourceConfigSchema() method.
+func MustAddBaseListSchema(s *listschema.Schema) {
+	mustAddListSchema(s, baseListSchema)
+}
+
+// MustAddBaseActionSchema adds the schema fields that are required for all
+// actions.
+//
+// This should be called from an action's Schema() method.
+func MustAddBaseActionSchema(s *actionschema.Schema) {
+	mustAddActionSchema(s, baseActionSchema)
+}
+
 // MustAddLegacyBaseSchema adds the schema fields that are required for
 // resources and data sources that have been migrated from SDKv2 to the
 // Terraform Plugin Framework.
@@ -101,10 +129,21 @@ func legacyBaseSchema() map[string]schema.Attribute {
 	}
 }
 
+// clusterDescription describes the cluster field, which selects one of the
+// cluster blocks of the provider.
+const clusterDescription = "Name of the provider cluster to manage the resource in. " +
+	"Defaults to the Vault server configured on the provider."
+
 type schemaFunc func() map[string]schema.Attribute
 
+type dataSourceSchemaFunc func() map[string]datasourceschema.Attribute
+
 type ephemeralSchemaFunc func() map[string]ephemeralschema.Attribute
 
+type listSchemaFunc func() map[string]listschema.Attribute
+
+type actionSchemaFunc func() map[string]actionschema.Attribute
+
 func baseSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		consts.FieldNamespace: schema.StringAttribute{
@@ -117,6 +156,26 @@ func baseSchema() map[string]schema.Attribute {
 				validators.PathValidator(),
 			},
 		},
+		consts.FieldCluster: schema.StringAttribute{
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			MarkdownDescription: clusterDescription,
+		},
+	}
+}
+
+func baseDataSourceSchema() map[string]datasourceschema.Attribute {
+	return map[string]datasourceschema.Attribute{
+		consts.FieldNamespace: datasourceschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Target namespace. (requires Enterprise)",
+		},
+		consts.FieldCluster: datasourceschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: clusterDescription,
+		},
 	}
 }
 
@@ -129,6 +188,10 @@ func baseEphemeralSchema() map[string]ephemeralschema.Attribute {
 				validators.PathValidator(),
 			},
 		},
+		consts.FieldCluster: ephemeralschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: clusterDescription,
+		},
 		consts.FieldMountID: ephemeralschema.StringAttribute{
 			Optional: true,
 			MarkdownDescription: "Terraform ID of the mount resource. Used to defer the provisioning " +
@@ -137,6 +200,38 @@ func baseEphemeralSchema() map[string]ephemeralschema.Attribute {
 	}
 }
 
+func baseListSchema() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		consts.FieldNamespace: listschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Target namespace. (requires Enterprise)",
+			Validators: []validator.String{
+				validators.PathValidator(),
+			},
+		},
+		consts.FieldCluster: listschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: clusterDescription,
+		},
+	}
+}
+
+func baseActionSchema() map[string]actionschema.Attribute {
+	return map[string]actionschema.Attribute{
+		consts.FieldNamespace: actionschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Target namespace. (requires Enterprise)",
+			Validators: []validator.String{
+				validators.PathValidator(),
+			},
+		},
+		consts.FieldCluster: actionschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: clusterDescription,
+		},
+	}
+}
+
 func mustAddSchema(s *schema.Schema, schemaFuncs ...schemaFunc) {
 	for _, f := range schemaFuncs {
 		for k, v := range f() {
@@ -149,6 +244,18 @@ func mustAddSchema(s *schema.Schema, schemaFuncs ...schemaFunc) {
 	}
 }
 
+func mustAddDataSourceSchema(s *datasourceschema.Schema, schemaFuncs ...dataSourceSchemaFunc) {
+	for _, f := range schemaFuncs {
+		for k, v := range f() {
+			if _, ok := s.Attributes[k]; ok {
+				panic(fmt.Sprintf("cannot add schema field %q, already exists in the Schema map", k))
+			}
+
+			s.Attributes[k] = v
+		}
+	}
+}
+
 func mustAddEphemeralSchema(s *ephemeralschema.Schema, schemaFuncs ...ephemeralSchemaFunc) {
 	for _, f := range schemaFuncs {
 		for k, v := range f() {
@@ -160,3 +267,35 @@ func mustAddEphemeralSchema(s *ephemeralschema.Schema, schemaFuncs ...ephemeralS
 		}
 	}
 }
+
+func mustAddListSchema(s *listschema.Schema, schemaFuncs ...listSchemaFunc) {
+	if s.Attributes == nil {
+		s.Attributes = map[string]listschema.Attribute{}
+	}
+
+	for _, f := range schemaFuncs {
+		for k, v := range f() {
+			if _, ok := s.Attributes[k]; ok {
+				panic(fmt.Sprintf("cannot add schema field %q, already exists in the Schema map", k))
+			}
+
+			s.Attributes[k] = v
+		}
+	}
+}
+
+func mustAddActionSchema(s *actionschema.Schema, schemaFuncs ...actionSchemaFunc) {
+	if s.Attributes == nil {
+		s.Attributes = map[string]actionschema.Attribute{}
+	}
+
+	for _, f := range schemaFuncs {
+		for k, v := range f() {
+			if _, ok := s.Attributes[k]; ok {
+				panic(fmt.Sprintf("cannot add schema field %q, already exists in the Schema map", k))
+			}
+
+			s.Attributes[k] = v
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// BaseModelWrapping describes the response-wrapping fields of Ephemeral
+// resources that return a secret.
+//
+// This struct should be embedded into the models of Ephemeral Resources that
+// call MustAddWrappingEphemeralSchema.
+type BaseModelWrapping struct {
+	WrapTTL          types.String `tfsdk:"wrap_ttl"`
+	WrappingToken    types.String `tfsdk:"wrapping_token"`
+	WrappingAccessor types.String `tfsdk:"wrapping_accessor"`
+}
+
+// MustAddWrappingEphemeralSchema adds the response-wrapping fields to the
+// schema of an Ephemeral Resource that returns a secret.
+//
+// This should be called from an ephemeral resource's Schema() method.
+func MustAddWrappingEphemeralSchema(s *ephemeralschema.Schema) {
+	mustAddEphemeralSchema(s, wrappingEphemeralSchema)
+}
+
+func wrappingEphemeralSchema() map[string]ephemeralschema.Attribute {
+	return map[string]ephemeralschema.Attribute{
+		consts.FieldWrapTTL: ephemeralschema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "The TTL of the response-wrapping token, e.g. `5m`. If set, Vault returns a " +
+				"single-use `wrapping_token` instead of the secret, and the other computed fields are not set.",
+		},
+		consts.FieldWrappingToken: ephemeralschema.StringAttribute{
+			Computed:            true,
+			Sensitive:           true,
+			MarkdownDescription: "The response-wrapping token. Only set when `wrap_ttl` is set.",
+		},
+		consts.FieldWrappingAccessor: ephemeralschema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The accessor of the response-wrapping token. Only set when `wrap_ttl` is set.",
+		},
+	}
+}
+
+// IsWrapped returns true if the response must be wrapped.
+func (m *BaseModelWrapping) IsWrapped() bool {
+	return m.WrapTTL.ValueString() != ""
+}
+
+// WrappingClient returns a clone of c that requests the wrapping of all its
+// responses when the response must be wrapped, otherwise c.
+func (m *BaseModelWrapping) WrappingClient(c *api.Client) (*api.Client, error) {
+	if !m.IsWrapped() {
+		return c, nil
+	}
+
+	c, err := c.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	wrapTTL := m.WrapTTL.ValueString()
+	c.SetWrappingLookupFunc(func(_, _ string) string {
+		return wrapTTL
+	})
+
+	return c, nil
+}
+
+// SetWrapInfo sets the wrapping token and accessor from the wrapping
+// information of secret. It returns false if secret is not wrapped.
+func (m *BaseModelWrapping) SetWrapInfo(secret *api.Secret) bool {
+	if secret == nil || secret.WrapInfo == nil {
+		return false
+	}
+
+	m.WrappingToken = types.StringValue(secret.WrapInfo.Token)
+	m.WrappingAccessor = types.StringValue(secret.WrapInfo.Accessor)
+
+	return true
+}
@@ -14,14 +14,13 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
-func GetClient(ctx context.Context, meta interface{}, namespace string) (*api.Client, error) {
-	var p *provider.ProviderMeta
-
-	switch v := meta.(type) {
-	case *provider.ProviderMeta:
-		p = v
-	default:
-		return nil, fmt.Errorf("meta argument must be a %T, not %T", p, meta)
+// GetClient returns the Vault client for the namespace of the named provider
+// cluster. An empty cluster selects the Vault server configured on the
+// provider.
+func GetClient(ctx context.Context, meta interface{}, namespace, cluster string) (*api.Client, error) {
+	p, err := GetClusterMeta(ctx, meta, cluster)
+	if err != nil {
+		return nil, err
 	}
 
 	ns := namespace
@@ -40,3 +39,45 @@ func GetClient(ctx context.Context, meta interface{}, namespace string) (*api.Cl
 
 	return p.GetClient()
 }
+
+// GetClusterMeta returns the ProviderMeta of the named provider cluster. An
+// empty cluster selects the provider's ProviderMeta.
+func GetClusterMeta(ctx context.Context, meta interface{}, cluster string) (*provider.ProviderMeta, error) {
+	var p *provider.ProviderMeta
+
+	switch v := meta.(type) {
+	case *provider.ProviderMeta:
+		p = v
+	default:
+		return nil, fmt.Errorf("meta argument must be a %T, not %T", p, meta)
+	}
+
+	c := cluster
+	if cluster == "" {
+		// in order to import resources from a cluster the user must provide
+		// the cluster from an environment variable.
+		c = os.Getenv(consts.EnvVarVaultClusterImport)
+		if c != "" {
+			tflog.Debug(ctx, fmt.Sprintf("Value for %q set from environment", consts.FieldCluster))
+		}
+	}
+
+	if c != "" {
+		return p.GetClusterMeta(c)
+	}
+
+	return p, nil
+}
+
+// GetMaxHTTPRetriesCCC returns the maximum number of retries for Client
+// Controlled Consistency related operations on the named provider cluster.
+// It returns provider.MaxHTTPRetriesCCC when the cluster can't be resolved,
+// GetClient returns the error in that case.
+func GetMaxHTTPRetriesCCC(ctx context.Context, meta interface{}, cluster string) int {
+	p, err := GetClusterMeta(ctx, meta, cluster)
+	if err != nil {
+		return provider.MaxHTTPRetriesCCC
+	}
+
+	return p.GetMaxHTTPRetriesCCC()
+}
@@ -36,6 +36,12 @@ func VaultDeleteErr(err error) (string, string) {
 			"HTTP Error: " + err.Error()
 }
 
+func VaultInvokeErr(err error) (string, string) {
+	return "Unable to Invoke Action",
+		unexpectedErr +
+			"HTTP Error: " + err.Error()
+}
+
 func VaultReadResponseNil() (string, string) {
 	return "Unable to Read Resource from Vault",
 		unexpectedErr +
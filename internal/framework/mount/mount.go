@@ -166,6 +166,22 @@ func GetMountAttributes(excludes ...string) map[string]schema.Attribute {
 	return s
 }
 
+// GetMountPathAttributes returns the schema attribute for the path of an
+// existing mount, for Plugin Framework resources that manage objects within
+// a backend, like roles or configs, rather than the mount itself. Changing the
+// mount path forces a new resource.
+func GetMountPathAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		consts.FieldMount: schema.StringAttribute{
+			MarkdownDescription: "The mount path for a back-end, for example, the path given in \"$ vault secrets enable -path=my-mount <type>\".",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
 // MustAddMountSchema adds the mount configuration schema attributes to the
 // given schema. It panics if any mount field collides with a field that
 // already exists in the schema, surfacing the conflict at startup. Resources
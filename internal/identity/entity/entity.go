@@ -177,6 +177,12 @@ func WithMaxRetryWait(d time.Duration) func(client *api.Client) {
 	}
 }
 
+func WithMaxRetries(maxRetries int) func(client *api.Client) {
+	return func(client *api.Client) {
+		client.SetMaxRetries(maxRetries)
+	}
+}
+
 func ReadEntity(client *api.Client, path string, retry bool, options ...func(client *api.Client)) (*api.Secret, error) {
 	log.Printf("[DEBUG] Reading Entity from %q", path)
 
@@ -187,7 +193,7 @@ func ReadEntity(client *api.Client, path string, retry bool, options ...func(cli
 			return nil, fmt.Errorf("error cloning client: %w", err)
 		}
 
-		setupCCCRetryClient(client, provider.MaxHTTPRetriesCCC, options...)
+		SetupCCCRetryClient(client, provider.MaxHTTPRetriesCCC, options...)
 	}
 
 	resp, err := client.Logical().Read(path)
@@ -202,9 +208,9 @@ func ReadEntity(client *api.Client, path string, retry bool, options ...func(cli
 	return resp, nil
 }
 
-// setupCCCRetryClient for handling Client Controlled Consistency related
+// SetupCCCRetryClient for handling Client Controlled Consistency related
 // requests.
-func setupCCCRetryClient(client *api.Client, maxRetry int, options ...func(client *api.Client)) {
+func SetupCCCRetryClient(client *api.Client, maxRetry int, options ...func(client *api.Client)) {
 	client.SetReadYourWrites(true)
 	client.SetMaxRetries(maxRetry)
 	client.SetCheckRetry(statusCheckRetry(http.StatusNotFound))
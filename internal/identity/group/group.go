@@ -31,11 +31,11 @@ func IdentityGroupIDPath(id string) string {
 }
 
 // ReadIdentityGroup may return `nil` for the IdentityGroup if it does not exist
-func ReadIdentityGroup(client *api.Client, groupID string, retry bool) (*api.Secret, error) {
+func ReadIdentityGroup(client *api.Client, groupID string, retry bool, options ...func(client *api.Client)) (*api.Secret, error) {
 	path := IdentityGroupIDPath(groupID)
 	log.Printf("[DEBUG] Reading IdentityGroup %s from %q", groupID, path)
 
-	return entity.ReadEntity(client, path, retry)
+	return entity.ReadEntity(client, path, retry, options...)
 }
 
 func IsIdentityNotFoundError(err error) bool {
@@ -87,7 +87,8 @@ func GetGroupMemberUpdateContextFunc(resourceType int) func(context.Context, *sc
 			log.Printf("[DEBUG] Group ID has changed old=%q, new=%q", o, n)
 		}
 
-		resp, err := ReadIdentityGroup(client, gid, d.IsNewResource())
+		resp, err := ReadIdentityGroup(client, gid, d.IsNewResource(),
+			entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -126,7 +127,8 @@ func GetGroupMemberReadContextFunc(resourceType int) schema.ReadContextFunc {
 		}
 
 		log.Printf("[DEBUG] Reading Identity Group %s with field %q", id, memberField)
-		resp, err := ReadIdentityGroup(client, id, d.IsNewResource())
+		resp, err := ReadIdentityGroup(client, id, d.IsNewResource(),
+			entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 		if err != nil {
 			if IsIdentityNotFoundError(err) {
 				log.Printf("[WARN] Identity Group %s not found, removing from state", id)
@@ -89,6 +89,7 @@ func GetResources() (map[string]*schema.Resource, error) {
 
 func mustAddCommonSchema(r *schema.Resource) *schema.Resource {
 	provider.MustAddNamespaceSchema(r.Schema)
+	provider.MustAddSchema(r, provider.GetClusterSchema())
 	provider.MustAddSchema(r,
 		map[string]*schema.Schema{
 			consts.FieldUUID: {
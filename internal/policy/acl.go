@@ -0,0 +1,352 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"sort"
+	"strings"
+)
+
+const capabilityDeny = "deny"
+
+// parameterCapabilities are the capabilities whose requests are checked
+// against the allowed, denied and required parameters of a rule.
+var parameterCapabilities = []string{
+	"create",
+	"read",
+	"update",
+	"patch",
+}
+
+// ACL evaluates a set of policies offline, the way Vault evaluates the
+// policies of a token. Rules for the same path are merged across policies,
+// an exact path takes precedence over glob (`*`) and segment wildcard (`+`)
+// paths, and among those only the highest priority match applies.
+type ACL struct {
+	exact    map[string]*PolicyRule
+	nonExact map[string]*PolicyRule
+}
+
+// NewACL merges the rules of the policies into an ACL.
+func NewACL(policies ...*Policy) *ACL {
+	a := &ACL{
+		exact:    map[string]*PolicyRule{},
+		nonExact: map[string]*PolicyRule{},
+	}
+
+	for _, p := range policies {
+		if p == nil {
+			continue
+		}
+		for _, rule := range p.Rules {
+			path := strings.TrimPrefix(rule.Path, "/")
+
+			rules := a.exact
+			if isNonExactPath(path) {
+				rules = a.nonExact
+			}
+
+			if existing, ok := rules[path]; ok {
+				mergeRule(existing, rule)
+			} else {
+				rules[path] = newMergedRule(path, rule)
+			}
+		}
+	}
+
+	return a
+}
+
+// Rule returns the merged rule that applies to path, or nil if no rule
+// applies.
+func (a *ACL) Rule(path string) *PolicyRule {
+	path = strings.TrimPrefix(path, "/")
+	if rule, ok := a.exact[path]; ok {
+		return rule
+	}
+
+	var best *wildcardPath
+	for rulePath, rule := range a.nonExact {
+		wc, ok := matchNonExactPath(rulePath, path)
+		if !ok {
+			continue
+		}
+		wc.rule = rule
+		if best == nil || best.lowerPriority(wc) {
+			best = wc
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+	return best.rule
+}
+
+// Capabilities returns the sorted capabilities granted on path, as returned
+// by Vault's sys/capabilities endpoint. It returns ["deny"] if no capability
+// is granted.
+func (a *ACL) Capabilities(path string) []string {
+	rule := a.Rule(path)
+	if rule == nil || len(rule.Capabilities) == 0 || containsString(rule.Capabilities, capabilityDeny) {
+		return []string{capabilityDeny}
+	}
+
+	caps := append([]string(nil), rule.Capabilities...)
+	sort.Strings(caps)
+	return caps
+}
+
+// Allowed returns true if a request with capability on path is allowed,
+// checking the request parameters against the allowed, denied and required
+// parameters of the rule that applies. Parameter values are compared as
+// strings, allowing the `*` prefix and suffix globs of Vault.
+func (a *ACL) Allowed(path, capability string, parameters map[string]string) bool {
+	rule := a.Rule(path)
+	if rule == nil || containsString(rule.Capabilities, capabilityDeny) || !containsString(rule.Capabilities, capability) {
+		return false
+	}
+
+	if !containsString(parameterCapabilities, capability) {
+		return true
+	}
+
+	return parametersAllowed(rule, parameters)
+}
+
+// parametersAllowed follows the order of the checks of Vault: required
+// parameters first, then denied parameters, then allowed parameters.
+func parametersAllowed(rule *PolicyRule, parameters map[string]string) bool {
+	params := make(map[string]string, len(parameters))
+	for k, v := range parameters {
+		params[strings.ToLower(k)] = v
+	}
+
+	for _, k := range rule.RequiredParameters {
+		if _, ok := params[strings.ToLower(k)]; !ok {
+			return false
+		}
+	}
+
+	if len(params) == 0 {
+		return true
+	}
+
+	if len(rule.DeniedParameters) > 0 {
+		if _, ok := rule.DeniedParameters["*"]; ok {
+			return false
+		}
+		for k, v := range params {
+			if values, ok := rule.DeniedParameters[k]; ok && valueInParameterList(v, values) {
+				return false
+			}
+		}
+	}
+
+	if len(rule.AllowedParameters) == 0 {
+		return true
+	}
+
+	_, allowedAll := rule.AllowedParameters["*"]
+	if len(rule.AllowedParameters) == 1 && allowedAll {
+		return true
+	}
+
+	for k, v := range params {
+		values, ok := rule.AllowedParameters[k]
+		if !ok && !allowedAll {
+			return false
+		}
+		if ok && !valueInParameterList(v, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valueInParameterList returns true if the list is empty, which allows any
+// value, or if one of its values matches v.
+func valueInParameterList(v string, list []string) bool {
+	if len(list) == 0 {
+		return true
+	}
+
+	for _, item := range list {
+		if globbedStringsMatch(item, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// globbedStringsMatch matches val against item, which may start and/or end
+// with a `*` glob.
+func globbedStringsMatch(item, val string) bool {
+	if len(item) < 2 {
+		return item == val
+	}
+
+	hasPrefix := strings.HasPrefix(item, "*")
+	hasSuffix := strings.HasSuffix(item, "*")
+	switch {
+	case hasPrefix && hasSuffix:
+		return strings.Contains(val, item[1:len(item)-1])
+	case hasPrefix:
+		return strings.HasSuffix(val, item[1:])
+	case hasSuffix:
+		return strings.HasPrefix(val, item[:len(item)-1])
+	default:
+		return item == val
+	}
+}
+
+// isNonExactPath returns true if the rule path is a glob or contains segment
+// wildcards.
+func isNonExactPath(path string) bool {
+	if strings.HasSuffix(path, "*") {
+		return true
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "+" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wildcardPath describes a non-exact rule path matching a request path, with
+// the properties used to prioritize it.
+type wildcardPath struct {
+	path          string
+	firstWCOrGlob int
+	wildcards     int
+	isPrefix      bool
+	rule          *PolicyRule
+}
+
+// lowerPriority returns true if p has a lower priority than other:
+//  1. its first wildcard or glob occurs earlier
+//  2. it ends in a glob and other doesn't
+//  3. it has more segment wildcards
+//  4. it is shorter
+//  5. it is smaller lexicographically
+func (p *wildcardPath) lowerPriority(other *wildcardPath) bool {
+	switch {
+	case p.firstWCOrGlob != other.firstWCOrGlob:
+		return p.firstWCOrGlob < other.firstWCOrGlob
+	case p.isPrefix != other.isPrefix:
+		return p.isPrefix
+	case p.wildcards != other.wildcards:
+		return p.wildcards > other.wildcards
+	case len(p.path) != len(other.path):
+		return len(p.path) < len(other.path)
+	default:
+		return p.path < other.path
+	}
+}
+
+// matchNonExactPath matches path against the non-exact rule path. A `+`
+// segment matches any single segment, and a trailing `*` matches any suffix.
+func matchNonExactPath(rulePath, path string) (*wildcardPath, bool) {
+	wc := &wildcardPath{
+		path:          rulePath,
+		firstWCOrGlob: strings.IndexAny(rulePath, "+*"),
+		isPrefix:      strings.HasSuffix(rulePath, "*"),
+	}
+
+	pattern := strings.TrimSuffix(rulePath, "*")
+	patternParts := strings.Split(pattern, "/")
+	pathParts := strings.Split(path, "/")
+
+	if len(pathParts) < len(patternParts) {
+		return nil, false
+	}
+	if !wc.isPrefix && len(pathParts) != len(patternParts) {
+		return nil, false
+	}
+
+	for i, part := range patternParts {
+		switch {
+		case part == "+":
+			wc.wildcards++
+		case part == pathParts[i]:
+		case wc.isPrefix && i == len(patternParts)-1 && strings.HasPrefix(pathParts[i], part):
+		default:
+			return nil, false
+		}
+	}
+
+	return wc, true
+}
+
+// newMergedRule returns a copy of rule, to be merged with the other rules of
+// the same path.
+func newMergedRule(path string, rule *PolicyRule) *PolicyRule {
+	merged := &PolicyRule{
+		Path:           path,
+		MinWrappingTTL: rule.MinWrappingTTL,
+		MaxWrappingTTL: rule.MaxWrappingTTL,
+	}
+	mergeRule(merged, rule)
+	return merged
+}
+
+// mergeRule merges rule into existing: deny overrides all the other
+// capabilities, the capabilities and required parameters are unioned, and an
+// empty list of parameter values allows any value.
+func mergeRule(existing, rule *PolicyRule) {
+	if containsString(existing.Capabilities, capabilityDeny) {
+		return
+	}
+	if containsString(rule.Capabilities, capabilityDeny) {
+		*existing = PolicyRule{
+			Path:         existing.Path,
+			Capabilities: []string{capabilityDeny},
+		}
+		return
+	}
+
+	for _, c := range rule.Capabilities {
+		if !containsString(existing.Capabilities, c) {
+			existing.Capabilities = append(existing.Capabilities, c)
+		}
+	}
+
+	for _, k := range rule.RequiredParameters {
+		if !containsString(existing.RequiredParameters, k) {
+			existing.RequiredParameters = append(existing.RequiredParameters, k)
+		}
+	}
+
+	existing.AllowedParameters = mergeParameters(existing.AllowedParameters, rule.AllowedParameters)
+	existing.DeniedParameters = mergeParameters(existing.DeniedParameters, rule.DeniedParameters)
+}
+
+func mergeParameters(existing, params map[string][]string) map[string][]string {
+	if len(params) == 0 {
+		return existing
+	}
+	if existing == nil {
+		existing = make(map[string][]string, len(params))
+	}
+
+	for k, values := range params {
+		k = strings.ToLower(k)
+		current, ok := existing[k]
+		switch {
+		case !ok:
+			existing[k] = append([]string{}, values...)
+		case len(current) == 0 || len(values) == 0:
+			existing[k] = []string{}
+		default:
+			existing[k] = append(current, values...)
+		}
+	}
+
+	return existing
+}
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testACL(t *testing.T, policies ...string) *ACL {
+	t.Helper()
+
+	parsed := make([]*Policy, 0, len(policies))
+	for _, p := range policies {
+		policy, err := Parse(p)
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		parsed = append(parsed, policy)
+	}
+
+	return NewACL(parsed...)
+}
+
+func TestACL_Capabilities(t *testing.T) {
+	acl := testACL(t, `
+path "secret/*" {
+  capabilities = ["read", "list"]
+}
+
+path "secret/prod/*" {
+  capabilities = ["deny"]
+}
+
+path "secret/+/config" {
+  capabilities = ["read"]
+}
+
+path "secret/+/config*" {
+  capabilities = ["list"]
+}
+
+path "secret/app/+/data" {
+  capabilities = ["update"]
+}
+
+path "secret/app/+/+" {
+  capabilities = ["delete"]
+}
+
+path "secret/exact" {
+  capabilities = ["create"]
+}
+
+path "secret/team-*" {
+  capabilities = ["patch"]
+}
+`, `
+path "secret/*" {
+  capabilities = ["update"]
+}
+
+path "secret/exact" {
+  capabilities = ["read"]
+}
+
+path "auth/token/*" {
+  capabilities = ["sudo", "update"]
+}
+
+path "auth/token/create" {
+  capabilities = ["deny"]
+}
+`)
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		// merged across policies
+		{path: "secret/foo", want: []string{"list", "read", "update"}},
+		{path: "secret/exact", want: []string{"create", "read"}},
+		// the longer glob has a later first glob
+		{path: "secret/prod/db", want: []string{"deny"}},
+		// the first wildcard of the segment wildcard path occurs later
+		{path: "secret/dev/config", want: []string{"read"}},
+		// a path not ending in a glob has a higher priority
+		{path: "secret/+/config", want: []string{"read"}},
+		// both end in a glob, more segment wildcards have a lower priority
+		{path: "secret/dev/configs", want: []string{"list", "read", "update"}},
+		// fewer segment wildcards have a higher priority
+		{path: "secret/app/web/data", want: []string{"update"}},
+		{path: "secret/app/web/other", want: []string{"delete"}},
+		// a glob may end in the middle of a segment
+		{path: "secret/team-a/key", want: []string{"patch"}},
+		// an exact deny overrides a glob
+		{path: "auth/token/create", want: []string{"deny"}},
+		{path: "auth/token/lookup", want: []string{"sudo", "update"}},
+		{path: "/auth/token/lookup", want: []string{"sudo", "update"}},
+		// no matching rule
+		{path: "sys/mounts", want: []string{"deny"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := acl.Capabilities(tt.path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Capabilities(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestACL_Rule(t *testing.T) {
+	acl := testACL(t, `
+path "secret/*" {
+  capabilities = ["read"]
+}
+
+path "secret/+/config" {
+  capabilities = ["read"]
+}
+`)
+
+	if rule := acl.Rule("secret/dev/config"); rule == nil || rule.Path != "secret/+/config" {
+		t.Errorf("Rule() = %v, want rule for %q", rule, "secret/+/config")
+	}
+	if rule := acl.Rule("secret/dev/config/more"); rule == nil || rule.Path != "secret/*" {
+		t.Errorf("Rule() = %v, want rule for %q", rule, "secret/*")
+	}
+	if rule := acl.Rule("auth/token/create"); rule != nil {
+		t.Errorf("Rule() = %v, want nil", rule)
+	}
+}
+
+func TestACL_Allowed(t *testing.T) {
+	acl := testACL(t, `
+path "secret/restricted" {
+  capabilities = ["create", "update", "list"]
+  allowed_parameters = {
+    "Name" = ["app-*", "*-service"]
+    "ttl"  = []
+  }
+  denied_parameters = {
+    "name" = ["app-admin"]
+  }
+  required_parameters = ["name"]
+}
+
+path "secret/open" {
+  capabilities = ["update"]
+  allowed_parameters = {
+    "*" = []
+  }
+  denied_parameters = {
+    "admin" = []
+  }
+}
+
+path "secret/none" {
+  capabilities = ["update"]
+  denied_parameters = {
+    "*" = []
+  }
+}
+`, `
+path "secret/restricted" {
+  capabilities = ["read"]
+  allowed_parameters = {
+    "ttl" = ["1h"]
+    "env" = ["dev"]
+  }
+}
+`)
+
+	tests := []struct {
+		name       string
+		path       string
+		capability string
+		params     map[string]string
+		want       bool
+	}{
+		{
+			name:       "allowed prefix glob",
+			path:       "secret/restricted",
+			capability: "create",
+			params:     map[string]string{"name": "app-web"},
+			want:       true,
+		},
+		{
+			name:       "allowed suffix glob",
+			path:       "secret/restricted",
+			capability: "update",
+			params:     map[string]string{"NAME": "db-service", "ttl": "5m"},
+			want:       true,
+		},
+		{
+			name:       "merged allowed parameter",
+			path:       "secret/restricted",
+			capability: "update",
+			params:     map[string]string{"name": "app-web", "env": "dev"},
+			want:       true,
+		},
+		{
+			name:       "value not allowed",
+			path:       "secret/restricted",
+			capability: "update",
+			params:     map[string]string{"name": "web"},
+			want:       false,
+		},
+		{
+			name:       "parameter not allowed",
+			path:       "secret/restricted",
+			capability: "update",
+			params:     map[string]string{"name": "app-web", "owner": "me"},
+			want:       false,
+		},
+		{
+			name:       "denied value",
+			path:       "secret/restricted",
+			capability: "update",
+			params:     map[string]string{"name": "app-admin"},
+			want:       false,
+		},
+		{
+			name:       "missing required parameter",
+			path:       "secret/restricted",
+			capability: "update",
+			params:     map[string]string{"ttl": "5m"},
+			want:       false,
+		},
+		{
+			name:       "parameters are not checked for list",
+			path:       "secret/restricted",
+			capability: "list",
+			want:       true,
+		},
+		{
+			name:       "capability not granted",
+			path:       "secret/restricted",
+			capability: "delete",
+			params:     map[string]string{"name": "app-web"},
+			want:       false,
+		},
+		{
+			name:       "all parameters allowed",
+			path:       "secret/open",
+			capability: "update",
+			params:     map[string]string{"foo": "bar"},
+			want:       true,
+		},
+		{
+			name:       "denied parameter with all parameters allowed",
+			path:       "secret/open",
+			capability: "update",
+			params:     map[string]string{"admin": "true"},
+			want:       false,
+		},
+		{
+			name:       "all parameters denied",
+			path:       "secret/none",
+			capability: "update",
+			params:     map[string]string{"foo": "bar"},
+			want:       false,
+		},
+		{
+			name:       "no parameters with all parameters denied",
+			path:       "secret/none",
+			capability: "update",
+			want:       true,
+		},
+		{
+			name:       "no matching rule",
+			path:       "secret/other",
+			capability: "read",
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acl.Allowed(tt.path, tt.capability, tt.params); got != tt.want {
+				t.Errorf("Allowed(%q, %q, %v) = %v, want %v", tt.path, tt.capability, tt.params, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+)
+
+// Policy is the in-memory representation of a Vault ACL policy document.
+type Policy struct {
+	Rules []*PolicyRule
+}
+
+type PolicyRule struct {
+	// Path in Vault that the rule applies to.
+	Path string
+
+	// Description is an optional annotation for the rule.
+	Description string
+
+	// MinWrappingTTL is the minimum allowed TTL for the wrapped response.
+	MinWrappingTTL string
+
+	// MaxWrappingTTL is the maximum allowed TTL for the wrapped response.
+	MaxWrappingTTL string
+
+	// Capabilities is the list of allowed operations on the specified path.
+	Capabilities []string
+
+	// RequiredParameters is a list of parameters that must be specified.
+	RequiredParameters []string
+
+	// SubscribeEventTypes is a list of event types to subscribe to when using `subscribe` capability.
+	SubscribeEventTypes []string
+
+	// AllowedParameters defines a whitelist of keys and values that are permitted on the given path.
+	AllowedParameters map[string][]string
+
+	// DeniedParameters defines a blacklist of keys and values that are denied on the given path.
+	DeniedParameters map[string][]string
+}
+
+// AllowedCapabilities lists every capability that may be granted by a policy
+// rule.
+var AllowedCapabilities = []string{
+	"create",
+	"read",
+	"update",
+	"delete",
+	"list",
+	"sudo",
+	"deny",
+	"patch",
+	"subscribe",
+}
+
+// legacyPolicyCapabilities maps the deprecated "policy" shorthand onto the
+// capabilities it expands to in Vault.
+var legacyPolicyCapabilities = map[string][]string{
+	"deny":  {"deny"},
+	"read":  {"read", "list"},
+	"write": {"create", "read", "update", "delete", "list"},
+	"sudo":  {"create", "read", "update", "delete", "list", "sudo"},
+}
+
+// validPathKeys are the keys Vault accepts inside a path stanza.
+var validPathKeys = []string{
+	"comment",
+	"policy",
+	"capabilities",
+	"allowed_parameters",
+	"denied_parameters",
+	"required_parameters",
+	"min_wrapping_ttl",
+	"max_wrapping_ttl",
+	"mfa_methods",
+	"control_group",
+	"subscribe_event_types",
+}
+
+// IsValidCapability returns true if c is one of AllowedCapabilities.
+func IsValidCapability(c string) bool {
+	for _, v := range AllowedCapabilities {
+		if c == v {
+			return true
+		}
+	}
+	return false
+}
+
+// RenderListOfStrings renders items as an HCL list of strings.
+func RenderListOfStrings(items []string) string {
+	if len(items) > 0 {
+		return fmt.Sprintf(`["%s"]`, strings.Join(items, `", "`))
+	}
+
+	return "[]"
+}
+
+func renderListOfMapsOfListToString(input map[string][]string) string {
+	output := fmt.Sprintf("{\n")
+
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		output = fmt.Sprintf("%s    \"%s\" = %s\n", output, k, RenderListOfStrings(input[k]))
+	}
+
+	return fmt.Sprintf("%s  }", output)
+}
+
+func renderPolicyRule(rule *PolicyRule) string {
+	renderedRule := fmt.Sprintf("path \"%s\" {\n", rule.Path)
+	renderedRule = fmt.Sprintf("%s  capabilities = %s\n", renderedRule, RenderListOfStrings(rule.Capabilities))
+
+	if rule.Description != "" {
+		renderedRule = fmt.Sprintf("# %s\n%s", rule.Description, renderedRule)
+	}
+
+	if len(rule.RequiredParameters) > 0 {
+		renderedRule = fmt.Sprintf("%s  required_parameters = %s\n", renderedRule, RenderListOfStrings(rule.RequiredParameters))
+	}
+
+	if len(rule.SubscribeEventTypes) > 0 {
+		renderedRule = fmt.Sprintf("%s  subscribe_event_types = %s\n", renderedRule, RenderListOfStrings(rule.SubscribeEventTypes))
+	}
+
+	if len(rule.AllowedParameters) > 0 {
+		renderedRule = fmt.Sprintf("%s  allowed_parameters = %s\n", renderedRule, renderListOfMapsOfListToString(rule.AllowedParameters))
+	}
+
+	if len(rule.DeniedParameters) > 0 {
+		renderedRule = fmt.Sprintf("%s  denied_parameters = %s\n", renderedRule, renderListOfMapsOfListToString(rule.DeniedParameters))
+	}
+
+	if rule.MinWrappingTTL != "" {
+		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = \"%s\"\n", renderedRule, rule.MinWrappingTTL)
+	}
+
+	if rule.MaxWrappingTTL != "" {
+		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = \"%s\"\n", renderedRule, rule.MaxWrappingTTL)
+	}
+
+	return fmt.Sprintf("%s}\n", renderedRule)
+}
+
+// Render serializes the policy as a standard Vault HCL policy document.
+func Render(policy *Policy) string {
+	var output string
+
+	for i, rule := range policy.Rules {
+		if i == 0 {
+			output = fmt.Sprintf("%s", renderPolicyRule(rule))
+		} else {
+			output = fmt.Sprintf("%s\n%s", output, renderPolicyRule(rule))
+		}
+	}
+
+	return output
+}
+
+// pathRuleHCL mirrors the HCL layout of a single path stanza, it is only used
+// for decoding.
+type pathRuleHCL struct {
+	Policy              string                   `hcl:"policy"`
+	Capabilities        []string                 `hcl:"capabilities"`
+	RequiredParameters  []string                 `hcl:"required_parameters"`
+	SubscribeEventTypes []string                 `hcl:"subscribe_event_types"`
+	AllowedParameters   map[string][]interface{} `hcl:"allowed_parameters"`
+	DeniedParameters    map[string][]interface{} `hcl:"denied_parameters"`
+	MinWrappingTTL      interface{}              `hcl:"min_wrapping_ttl"`
+	MaxWrappingTTL      interface{}              `hcl:"max_wrapping_ttl"`
+}
+
+// Parse decodes a Vault HCL policy document into a Policy. The rules are
+// returned in the order they appear in the document. A comment directly above
+// a path stanza is returned as the rule's Description, which makes Parse the
+// inverse of Render.
+func Parse(rules string) (*Policy, error) {
+	root, err := hcl.Parse(rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse policy: does not contain a root object")
+	}
+
+	if err := checkHCLKeys(list, []string{"name", "path"}); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	policy := &Policy{}
+	for _, item := range list.Filter("path").Items {
+		rule, err := parsePathItem(item)
+		if err != nil {
+			return nil, err
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	return policy, nil
+}
+
+func parsePathItem(item *ast.ObjectItem) (*PolicyRule, error) {
+	if len(item.Keys) == 0 {
+		return nil, fmt.Errorf("failed to parse policy: path stanza is missing its path")
+	}
+
+	key, ok := item.Keys[0].Token.Value().(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse policy: invalid path %q", item.Keys[0].Token.Text)
+	}
+
+	obj, ok := item.Val.(*ast.ObjectType)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse policy: path %q: expected an object", key)
+	}
+
+	if err := checkHCLKeys(obj.List, validPathKeys); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: path %q: %w", key, err)
+	}
+
+	var raw pathRuleHCL
+	if err := hcl.DecodeObject(&raw, item.Val); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: path %q: %w", key, err)
+	}
+
+	rule := &PolicyRule{
+		Path:                key,
+		Description:         leadComment(item),
+		RequiredParameters:  raw.RequiredParameters,
+		SubscribeEventTypes: raw.SubscribeEventTypes,
+		AllowedParameters:   stringifyParameters(raw.AllowedParameters),
+		DeniedParameters:    stringifyParameters(raw.DeniedParameters),
+	}
+
+	if raw.Policy != "" {
+		caps, ok := legacyPolicyCapabilities[raw.Policy]
+		if !ok {
+			return nil, fmt.Errorf("failed to parse policy: path %q: invalid policy %q", key, raw.Policy)
+		}
+		rule.Capabilities = append(rule.Capabilities, caps...)
+	}
+
+	for _, c := range raw.Capabilities {
+		if !IsValidCapability(c) {
+			return nil, fmt.Errorf("failed to parse policy: path %q: invalid capability %q", key, c)
+		}
+		if !containsString(rule.Capabilities, c) {
+			rule.Capabilities = append(rule.Capabilities, c)
+		}
+	}
+
+	if raw.MinWrappingTTL != nil {
+		rule.MinWrappingTTL = fmt.Sprintf("%v", raw.MinWrappingTTL)
+	}
+
+	if raw.MaxWrappingTTL != nil {
+		rule.MaxWrappingTTL = fmt.Sprintf("%v", raw.MaxWrappingTTL)
+	}
+
+	return rule, nil
+}
+
+// checkHCLKeys ensures that every key in list is one of valid.
+func checkHCLKeys(list *ast.ObjectList, valid []string) error {
+	for _, item := range list.Items {
+		if len(item.Keys) == 0 {
+			continue
+		}
+		k := item.Keys[0].Token.Value()
+		s, ok := k.(string)
+		if !ok || !containsString(valid, s) {
+			return fmt.Errorf("invalid key %q on line %d", item.Keys[0].Token.Text, item.Keys[0].Pos().Line)
+		}
+	}
+
+	return nil
+}
+
+// leadComment returns the text of the comment directly preceding a path
+// stanza, with the comment markers removed.
+func leadComment(item *ast.ObjectItem) string {
+	if item.LeadComment == nil {
+		return ""
+	}
+
+	var lines []string
+	for _, c := range item.LeadComment.List {
+		text := c.Text
+		switch {
+		case strings.HasPrefix(text, "#"):
+			text = strings.TrimPrefix(text, "#")
+		case strings.HasPrefix(text, "//"):
+			text = strings.TrimPrefix(text, "//")
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		}
+		lines = append(lines, strings.TrimSpace(text))
+	}
+
+	return strings.Join(lines, " ")
+}
+
+func stringifyParameters(in map[string][]interface{}) map[string][]string {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(map[string][]string, len(in))
+	for k, values := range in {
+		out[k] = make([]string, 0, len(values))
+		for _, v := range values {
+			out[k] = append(out[k], fmt.Sprintf("%v", v))
+		}
+	}
+
+	return out
+}
+
+func containsString(items []string, s string) bool {
+	for _, v := range items {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	p := &Policy{
+		Rules: []*PolicyRule{
+			{
+				Path:                "secret/test1/*",
+				Description:         "test rule 1",
+				Capabilities:        []string{"create", "read"},
+				RequiredParameters:  []string{"test_param1"},
+				SubscribeEventTypes: []string{"test_events1"},
+				AllowedParameters: map[string][]string{
+					"spam": {"eggs"},
+					"eggs": {"foo", "bar"},
+				},
+				DeniedParameters: map[string][]string{
+					"*": {},
+				},
+				MaxWrappingTTL: "1h",
+			},
+			{
+				Path:           "secret/test2/*",
+				Capabilities:   []string{"list"},
+				MinWrappingTTL: "1s",
+			},
+		},
+	}
+
+	expected := `# test rule 1
+path "secret/test1/*" {
+  capabilities = ["create", "read"]
+  required_parameters = ["test_param1"]
+  subscribe_event_types = ["test_events1"]
+  allowed_parameters = {
+    "eggs" = ["foo", "bar"]
+    "spam" = ["eggs"]
+  }
+  denied_parameters = {
+    "*" = []
+  }
+  max_wrapping_ttl = "1h"
+}
+
+path "secret/test2/*" {
+  capabilities = ["list"]
+  min_wrapping_ttl = "1s"
+}
+`
+
+	if actual := Render(p); actual != expected {
+		t.Errorf("Render() got:\n%s\nwant:\n%s", actual, expected)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		hcl           string
+		want          *Policy
+		wantErr       bool
+		errorContains string
+	}{
+		{
+			name: "basic",
+			hcl: `
+# allow reads
+path "secret/*" {
+  capabilities = ["read", "list"]
+}
+
+path "sys/mounts" {
+  capabilities = ["read"]
+  min_wrapping_ttl = "1s"
+  max_wrapping_ttl = 90
+}
+`,
+			want: &Policy{
+				Rules: []*PolicyRule{
+					{
+						Path:         "secret/*",
+						Description:  "allow reads",
+						Capabilities: []string{"read", "list"},
+					},
+					{
+						Path:           "sys/mounts",
+						Capabilities:   []string{"read"},
+						MinWrappingTTL: "1s",
+						MaxWrappingTTL: "90",
+					},
+				},
+			},
+		},
+		{
+			name: "parameters",
+			hcl: `
+path "secret/foo" {
+  capabilities = ["create"]
+  required_parameters = ["bar"]
+  allowed_parameters = {
+    "bar" = ["zip", 1, true]
+    "baz" = []
+  }
+  denied_parameters = {
+    "*" = []
+  }
+}
+`,
+			want: &Policy{
+				Rules: []*PolicyRule{
+					{
+						Path:               "secret/foo",
+						Capabilities:       []string{"create"},
+						RequiredParameters: []string{"bar"},
+						AllowedParameters: map[string][]string{
+							"bar": {"zip", "1", "true"},
+							"baz": {},
+						},
+						DeniedParameters: map[string][]string{
+							"*": {},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "legacy policy merged with capabilities",
+			hcl: `
+path "secret/foo" {
+  policy       = "read"
+  capabilities = ["list", "update"]
+}
+`,
+			want: &Policy{
+				Rules: []*PolicyRule{
+					{
+						Path:         "secret/foo",
+						Capabilities: []string{"read", "list", "update"},
+					},
+				},
+			},
+		},
+		{
+			name:          "invalid capability",
+			hcl:           `path "secret/foo" { capabilities = ["write"] }`,
+			wantErr:       true,
+			errorContains: `invalid capability "write"`,
+		},
+		{
+			name:          "invalid path key",
+			hcl:           `path "secret/foo" { capabilites = ["read"] }`,
+			wantErr:       true,
+			errorContains: `invalid key "capabilites"`,
+		},
+		{
+			name:          "invalid root key",
+			hcl:           `paths "secret/foo" { capabilities = ["read"] }`,
+			wantErr:       true,
+			errorContains: `invalid key "paths"`,
+		},
+		{
+			name:          "invalid hcl",
+			hcl:           `path "secret/foo" {`,
+			wantErr:       true,
+			errorContains: "failed to parse policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.hcl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("Parse() error = %v, expected to contain %q", err, tt.errorContains)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_roundTrip(t *testing.T) {
+	p := &Policy{
+		Rules: []*PolicyRule{
+			{
+				Path:                "secret/+/config",
+				Description:         "round trip",
+				Capabilities:        []string{"read", "subscribe"},
+				SubscribeEventTypes: []string{"kv*"},
+				AllowedParameters: map[string][]string{
+					"ttl": {"1h", "2h"},
+				},
+				MaxWrappingTTL: "1h",
+			},
+		},
+	}
+
+	got, err := Parse(Render(p))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, p) {
+		t.Errorf("Parse(Render()) got %#v, want %#v", got, p)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func init() {
+	field := consts.FieldAuthLoginAgent
+	if err := globalAuthLoginRegistry.Register(field,
+		func(r *schema.ResourceData) (AuthLogin, error) {
+			a := &AuthLoginAgent{}
+			return a.Init(r, field)
+		}, GetAgentLoginSchema); err != nil {
+		panic(err)
+	}
+}
+
+// GetAgentLoginSchema for the Vault Agent auto-auth token.
+func GetAgentLoginSchema(authField string) *schema.Schema {
+	return getLoginSchema(
+		authField,
+		"Use the auto-auth token of a Vault Agent or Vault Proxy. "+
+			"Requires use_auto_auth_token to be enabled on the agent's API proxy.",
+		GetAgentLoginSchemaResource,
+	)
+}
+
+// GetAgentLoginSchemaResource for the Vault Agent auto-auth token.
+func GetAgentLoginSchemaResource(authField string) *schema.Resource {
+	return mustAddLoginSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}, authField, consts.MountTypeNone)
+}
+
+var _ AuthLogin = (*AuthLoginAgent)(nil)
+
+// AuthLoginAgent is used when the provider sends its requests to a Vault
+// Agent or Vault Proxy that has use_auto_auth_token enabled. The agent adds
+// its auto-auth token to every request, and manages the token's lifecycle, so
+// the provider never holds a Vault token of its own.
+type AuthLoginAgent struct {
+	AuthLoginCommon
+}
+
+// MountPath is unused
+func (l *AuthLoginAgent) MountPath() string {
+	return ""
+}
+
+// LoginPath is unused
+func (l *AuthLoginAgent) LoginPath() string {
+	return ""
+}
+
+func (l *AuthLoginAgent) Init(d *schema.ResourceData,
+	authField string,
+) (AuthLogin, error) {
+	l.mount = consts.MountTypeNone
+	if err := l.AuthLoginCommon.Init(d, authField); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Method is unused.
+func (l *AuthLoginAgent) Method() string {
+	return ""
+}
+
+// Login does not authenticate to Vault, since the agent authenticates every
+// request on the provider's behalf. The returned secret has no client token.
+func (l *AuthLoginAgent) Login(_ *api.Client) (*api.Secret, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	return &api.Secret{
+		Auth: &api.SecretAuth{},
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestAuthLoginAgent_Init(t *testing.T) {
+	tests := []authLoginInitTest{
+		{
+			name:      "basic",
+			authField: consts.FieldAuthLoginAgent,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginAgent: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+					},
+				},
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+			},
+			wantErr: false,
+		},
+		{
+			name:         "error-missing-resource",
+			authField:    consts.FieldAuthLoginAgent,
+			expectParams: nil,
+			wantErr:      true,
+			expectErr:    fmt.Errorf("resource data missing field %q", consts.FieldAuthLoginAgent),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := map[string]*schema.Schema{
+				tt.authField: GetAgentLoginSchema(tt.authField),
+			}
+			assertAuthLoginInit(t, tt, s, &AuthLoginAgent{})
+		})
+	}
+}
+
+func TestProviderMeta_AgentUnixSocket(t *testing.T) {
+	socket := path.Join(t.TempDir(), "agent.sock")
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token := r.Header.Get(api.AuthHeaderName); token != "" {
+				t.Errorf("expected no token to be sent to the agent, actual %q", token)
+			}
+			paths = append(paths, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"data": {"keys": ["default"]}}`)
+		}),
+	}
+	go server.Serve(ln)
+	defer server.Close()
+
+	t.Setenv(api.EnvVaultToken, "env-token")
+
+	pr := &schema.Resource{
+		Schema: NewProvider(nil, nil).Schema,
+	}
+	d := pr.TestResourceData()
+	if err := d.Set(consts.FieldAddress, "unix://"+socket); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set(consts.FieldAuthLoginAgent, []interface{}{
+		map[string]interface{}{},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := NewProviderMeta(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := meta.(*ProviderMeta).GetClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Sys().ListPolicies(); err != nil {
+		t.Fatal(err)
+	}
+
+	// no token lookup or child token creation is expected
+	expected := []string{"/v1/sys/policies/acl"}
+	if len(paths) != len(expected) || paths[0] != expected[0] {
+		t.Errorf("expected requests %v, actual %v", expected, paths)
+	}
+}
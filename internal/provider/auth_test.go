@@ -21,7 +21,7 @@ import (
 
 // expectedRegisteredAuthLogin value should be modified when adding
 // registering/de-registering AuthLogin resources.
-const expectedRegisteredAuthLogin = 12
+const expectedRegisteredAuthLogin = 13
 
 type authLoginTest struct {
 	name               string
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// GetClusterSchemaResource returns the schema.Resource for a provider cluster
+// block. This schema must match exactly the fwprovider (Terraform Plugin
+// Framework) cluster block.
+func GetClusterSchemaResource() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster, referenced by the cluster argument of resources and data sources.",
+			},
+			consts.FieldAddress: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "URL of the root of the Vault server.",
+			},
+			consts.FieldToken: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Token to use to authenticate to the Vault server.",
+			},
+			consts.FieldTokenName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Token name to use for creating the Vault child token.",
+			},
+			consts.FieldNamespace: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The namespace to use. Available only for Vault Enterprise.",
+			},
+			consts.FieldCACertFile: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a CA certificate file to validate the server's certificate.",
+			},
+			consts.FieldCACertDir: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to directory containing CA certificate files to validate the server's certificate.",
+			},
+			consts.FieldSkipTLSVerify: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set this to true only if the Vault server is an insecure development instance.",
+			},
+			consts.FieldTLSServerName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name to use as the SNI host when connecting via TLS.",
+			},
+			consts.FieldSkipChildToken: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
+			},
+			consts.FieldMaxLeaseTTLSeconds: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum TTL for secret leases requested by this provider.",
+			},
+			consts.FieldMaxRetries: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of retries when a 5xx error code is encountered.",
+			},
+			consts.FieldMaxRetriesCCC: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of retries for Client Controlled Consistency related operations",
+			},
+			consts.FieldSkipGetVaultVersion: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the dynamic fetching of the Vault server version.",
+			},
+		},
+	}
+
+	MustAddAuthLoginSchema(r.Schema)
+	for _, s := range r.Schema {
+		clearSchemaReferences(s)
+	}
+
+	return r
+}
+
+// clearSchemaReferences removes all references to other fields from s and its
+// nested schemas. The references are absolute, so they would refer to the
+// provider's fields rather than to the cluster's.
+func clearSchemaReferences(s *schema.Schema) {
+	s.ConflictsWith = nil
+	s.RequiredWith = nil
+	s.ExactlyOneOf = nil
+	s.AtLeastOneOf = nil
+
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range r.Schema {
+			clearSchemaReferences(v)
+		}
+	}
+}
+
+// newClusterMetas returns a ProviderMeta for each cluster block configured on
+// the provider, keyed by the cluster's name. Like the provider's ProviderMeta,
+// each one connects to its Vault server on first use.
+func newClusterMetas(d *schema.ResourceData) (map[string]*ProviderMeta, error) {
+	clusters, _ := d.Get(consts.FieldCluster).([]interface{})
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	r := GetClusterSchemaResource()
+	metas := make(map[string]*ProviderMeta, len(clusters))
+	for i, v := range clusters {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := m[consts.FieldName].(string)
+		if _, ok := metas[name]; ok {
+			return nil, fmt.Errorf("duplicate %s name %q", consts.FieldCluster, name)
+		}
+
+		// the raw config is required by GetResourceDataBool, and by auth_login
+		// methods that read their values from it.
+		clusterConfig := cty.NullVal(cty.DynamicPseudoType)
+		if !rawConfig.IsNull() {
+			clusterConfig = rawConfig.GetAttr(consts.FieldCluster).Index(cty.NumberIntVal(int64(i)))
+		}
+
+		cd := r.Data(&terraform.InstanceState{RawConfig: clusterConfig})
+		for k, v := range m {
+			if err := cd.Set(k, v); err != nil {
+				return nil, fmt.Errorf("failed to configure %s %q: %w", consts.FieldCluster, name, err)
+			}
+		}
+
+		// the provider's token sources, like VAULT_TOKEN, belong to the
+		// provider's Vault server and must not be sent to another one.
+		if m[consts.FieldToken].(string) == "" && !hasAuthLogin(cd) {
+			return nil, fmt.Errorf("%s %q requires a %s or an auth_login method",
+				consts.FieldCluster, name, consts.FieldToken)
+		}
+
+		metas[name] = &ProviderMeta{
+			resourceData: cd,
+			cluster:      name,
+		}
+	}
+
+	return metas, nil
+}
+
+func hasAuthLogin(d *schema.ResourceData) bool {
+	for _, authField := range globalAuthLoginRegistry.Fields() {
+		if _, ok := d.GetOk(authField); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetClusterMeta returns the ProviderMeta of the named provider cluster.
+func (p *ProviderMeta) GetClusterMeta(name string) (*ProviderMeta, error) {
+	c, ok := p.clusters[name]
+	if !ok {
+		return nil, fmt.Errorf("%s %q is not configured on the provider", consts.FieldCluster, name)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestProviderMeta_Cluster(t *testing.T) {
+	// each server only accepts its own token, and the child tokens created
+	// from it.
+	newServer := func(t *testing.T, token string) *httptest.Server {
+		t.Helper()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actual := r.Header.Get(api.AuthHeaderName); !strings.HasPrefix(actual, token) {
+				t.Errorf("expected token %q, actual %q", token, actual)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/v1/auth/token/create" {
+				fmt.Fprintf(w, `{"auth": {"client_token": "%s-child", "policies": ["root"]}}`, token)
+				return
+			}
+			fmt.Fprint(w, `{"data": {"ttl": 0, "policies": ["root"]}}`)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	primary := newServer(t, "primary-token")
+	dr := newServer(t, "dr-token")
+
+	t.Setenv(api.EnvVaultToken, "env-token")
+
+	newMeta := func(t *testing.T, clusters []interface{}) (*ProviderMeta, error) {
+		t.Helper()
+		pr := &schema.Resource{
+			Schema: NewProvider(nil, nil).Schema,
+		}
+		d := pr.TestResourceData()
+		if err := d.Set(consts.FieldAddress, primary.URL); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Set(consts.FieldToken, "primary-token"); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Set(consts.FieldCluster, clusters); err != nil {
+			t.Fatal(err)
+		}
+
+		meta, err := NewProviderMeta(d)
+		if err != nil {
+			return nil, err
+		}
+
+		return meta.(*ProviderMeta), nil
+	}
+
+	resourceSchema := map[string]*schema.Schema{}
+	MustAddNamespaceSchema(resourceSchema)
+	for k, v := range GetClusterSchema() {
+		resourceSchema[k] = v
+	}
+
+	t.Run("routing", func(t *testing.T) {
+		meta, err := newMeta(t, []interface{}{
+			map[string]interface{}{
+				consts.FieldName:      "dr",
+				consts.FieldAddress:   dr.URL,
+				consts.FieldToken:     "dr-token",
+				consts.FieldNamespace: "ns1",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			name          string
+			raw           map[string]interface{}
+			wantAddr      string
+			wantNamespace string
+			wantErr       bool
+		}{
+			{
+				name:     "default",
+				raw:      map[string]interface{}{},
+				wantAddr: primary.URL,
+			},
+			{
+				name: "cluster",
+				raw: map[string]interface{}{
+					consts.FieldCluster: "dr",
+				},
+				wantAddr:      dr.URL,
+				wantNamespace: "ns1",
+			},
+			{
+				name: "cluster-namespace",
+				raw: map[string]interface{}{
+					consts.FieldCluster:   "dr",
+					consts.FieldNamespace: "team",
+				},
+				wantAddr:      dr.URL,
+				wantNamespace: "ns1/team",
+			},
+			{
+				name: "unknown-cluster",
+				raw: map[string]interface{}{
+					consts.FieldCluster: "unknown",
+				},
+				wantErr: true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				d := schema.TestResourceDataRaw(t, resourceSchema, tt.raw)
+				client, err := GetClient(d, meta)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("GetClient() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+
+				if actual := client.Address(); actual != tt.wantAddr {
+					t.Errorf("expected address %q, actual %q", tt.wantAddr, actual)
+				}
+				if actual := client.Namespace(); actual != tt.wantNamespace {
+					t.Errorf("expected namespace %q, actual %q", tt.wantNamespace, actual)
+				}
+
+				// ensure that the expected token is sent to each server
+				if _, err := client.Auth().Token().LookupSelf(); err != nil {
+					t.Fatal(err)
+				}
+			})
+		}
+	})
+
+	t.Run("max-retries-ccc", func(t *testing.T) {
+		t.Setenv("VAULT_MAX_RETRIES_CCC", "")
+		meta, err := newMeta(t, []interface{}{
+			map[string]interface{}{
+				consts.FieldName:          "dr",
+				consts.FieldAddress:       dr.URL,
+				consts.FieldToken:         "dr-token",
+				consts.FieldMaxRetriesCCC: 3,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			name string
+			raw  map[string]interface{}
+			want int
+		}{
+			{
+				name: "default",
+				raw:  map[string]interface{}{},
+				want: DefaultMaxHTTPRetriesCCC,
+			},
+			{
+				name: "cluster",
+				raw: map[string]interface{}{
+					consts.FieldCluster: "dr",
+				},
+				want: 3,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				d := schema.TestResourceDataRaw(t, resourceSchema, tt.raw)
+				if actual := GetMaxHTTPRetriesCCC(d, meta); actual != tt.want {
+					t.Errorf("expected max retries %d, actual %d", tt.want, actual)
+				}
+			})
+		}
+	})
+
+	t.Run("duplicate-name", func(t *testing.T) {
+		c := map[string]interface{}{
+			consts.FieldName:    "dr",
+			consts.FieldAddress: dr.URL,
+			consts.FieldToken:   "dr-token",
+		}
+		if _, err := newMeta(t, []interface{}{c, c}); err == nil {
+			t.Fatal("expected an error for duplicate cluster names")
+		}
+	})
+
+	t.Run("missing-token", func(t *testing.T) {
+		// the VAULT_TOKEN set above must not be used for the cluster.
+		if _, err := newMeta(t, []interface{}{
+			map[string]interface{}{
+				consts.FieldName:    "dr",
+				consts.FieldAddress: dr.URL,
+			},
+		}); err == nil {
+			t.Fatal("expected an error for a cluster without a token")
+		}
+	})
+}
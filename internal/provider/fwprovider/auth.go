@@ -15,6 +15,25 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
 )
 
+// authLoginBlocks returns the blocks of all supported auth login methods.
+func authLoginBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		consts.FieldAuthLoginAgent:     AuthLoginAgentSchema(),
+		consts.FieldAuthLoginAWS:       AuthLoginAWSSchema(),
+		consts.FieldAuthLoginAzure:     AuthLoginAzureSchema(),
+		consts.FieldAuthLoginCert:      AuthLoginCertSchema(),
+		consts.FieldAuthLoginGCP:       AuthLoginGCPSchema(),
+		consts.FieldAuthLoginGeneric:   AuthLoginGenericSchema(),
+		consts.FieldAuthLoginJWT:       AuthLoginJWTSchema(),
+		consts.FieldAuthLoginKerberos:  AuthLoginKerberosSchema(),
+		consts.FieldAuthLoginOCI:       AuthLoginOCISchema(),
+		consts.FieldAuthLoginOIDC:      AuthLoginOIDCSchema(),
+		consts.FieldAuthLoginRadius:    AuthLoginRadiusSchema(),
+		consts.FieldAuthLoginTokenFile: AuthLoginTokenFileSchema(),
+		consts.FieldAuthLoginUserpass:  AuthLoginUserpassSchema(),
+	}
+}
+
 func mustAddLoginSchema(s *schema.ListNestedBlock, defaultMount string) schema.Block {
 	m := map[string]schema.Attribute{
 		consts.FieldNamespace: schema.StringAttribute{
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func AuthLoginAgentSchema() schema.Block {
+	return mustAddLoginSchema(&schema.ListNestedBlock{
+		Description: "Use the auto-auth token of a Vault Agent or Vault Proxy. " +
+			"Requires use_auto_auth_token to be enabled on the agent's API proxy.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{},
+		},
+	}, consts.MountTypeNone)
+}
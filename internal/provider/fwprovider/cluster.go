@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// ClusterSchema must match exactly the SDKv2 provider's cluster schema.
+func ClusterSchema() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Additional Vault servers that resources and data sources " +
+			"can be managed in, selected with their cluster argument.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				consts.FieldName: schema.StringAttribute{
+					Required:    true,
+					Description: "Name of the cluster, referenced by the cluster argument of resources and data sources.",
+				},
+				consts.FieldAddress: schema.StringAttribute{
+					Required:    true,
+					Description: "URL of the root of the Vault server.",
+				},
+				consts.FieldToken: schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Token to use to authenticate to the Vault server.",
+				},
+				consts.FieldTokenName: schema.StringAttribute{
+					Optional:    true,
+					Description: "Token name to use for creating the Vault child token.",
+				},
+				consts.FieldNamespace: schema.StringAttribute{
+					Optional:    true,
+					Description: "The namespace to use. Available only for Vault Enterprise.",
+				},
+				consts.FieldCACertFile: schema.StringAttribute{
+					Optional:    true,
+					Description: "Path to a CA certificate file to validate the server's certificate.",
+				},
+				consts.FieldCACertDir: schema.StringAttribute{
+					Optional:    true,
+					Description: "Path to directory containing CA certificate files to validate the server's certificate.",
+				},
+				consts.FieldSkipTLSVerify: schema.BoolAttribute{
+					Optional:    true,
+					Description: "Set this to true only if the Vault server is an insecure development instance.",
+				},
+				consts.FieldTLSServerName: schema.StringAttribute{
+					Optional:    true,
+					Description: "Name to use as the SNI host when connecting via TLS.",
+				},
+				consts.FieldSkipChildToken: schema.BoolAttribute{
+					Optional:    true,
+					Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
+				},
+				consts.FieldMaxLeaseTTLSeconds: schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum TTL for secret leases requested by this provider.",
+				},
+				consts.FieldMaxRetries: schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum number of retries when a 5xx error code is encountered.",
+				},
+				consts.FieldMaxRetriesCCC: schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum number of retries for Client Controlled Consistency related operations",
+				},
+				consts.FieldSkipGetVaultVersion: schema.BoolAttribute{
+					Optional:    true,
+					Description: "Skip the dynamic fetching of the Vault server version.",
+				},
+			},
+			Blocks: authLoginBlocks(),
+		},
+	}
+}
@@ -10,12 +10,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	sdkv2provider "github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/auth/cloudfoundry"
@@ -25,15 +29,22 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/auth/spiffe"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/auth/userpass"
 	ephemeralgeneric "github.com/hashicorp/terraform-provider-vault/internal/vault/generic"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/identity"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/keymgmt"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/alicloud"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/azure"
 	ephemeralsecrets "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/ephemeral"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/gcpkms"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/kmip"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/kv"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/os"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/pki"
 	pki_external_ca "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/pki-external-ca"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/rotate"
 	spiffesec "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/spiffe"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/ssh"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/totp"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/transit"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/sys"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/sys/config"
 	sysconfig "github.com/hashicorp/terraform-provider-vault/internal/vault/sys/config"
@@ -41,6 +52,12 @@ import (
 
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
+var _ provider.ProviderWithFunctions = &fwprovider{}
+
+var _ provider.ProviderWithListResources = &fwprovider{}
+
+var _ provider.ProviderWithActions = &fwprovider{}
+
 // Ensure the implementation satisfies the provider.Provider interface
 var _ provider.Provider = &fwprovider{}
 
@@ -131,6 +148,20 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			consts.FieldMaxRequestsPerSecond: schema.Float64Attribute{
+				Optional: true,
+				Description: "Maximum number of requests per second sent to Vault, " +
+					"shared by all namespaces. Defaults to unlimited.",
+			},
+			consts.FieldMaxRequestsBurst: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests sent to Vault in a single burst when max_requests_per_second is set.",
+			},
+			consts.FieldMaxConcurrentRequests: schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of requests to Vault that are in flight at the same time, " +
+					"shared by all namespaces. Defaults to unlimited.",
+			},
 			consts.FieldNamespace: schema.StringAttribute{
 				Optional:    true,
 				Description: "The namespace to use. Available only for Vault Enterprise.",
@@ -139,6 +170,17 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip the dynamic fetching of the Vault server version.",
 			},
+			consts.FieldEnableReadCache: schema.BoolAttribute{
+				Optional: true,
+				Description: "Cache the responses of Vault read requests for the duration of " +
+					"a Terraform run. Cached responses are invalidated by writes to the same path.",
+			},
+			consts.FieldRenewToken: schema.BoolAttribute{
+				Optional: true,
+				Description: "Renew the provider's Vault token in the background for the duration of " +
+					"a Terraform run. Once the token can no longer be renewed, a new token is " +
+					"created with the configured auth_login method, or from the parent token when child tokens are enabled.",
+			},
 			consts.FieldVaultVersionOverride: schema.StringAttribute{
 				Optional: true,
 				Description: "Override the Vault server version, " +
@@ -194,20 +236,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					listvalidator.SizeAtMost(1),
 				},
 			},
-			consts.FieldAuthLoginAWS:       AuthLoginAWSSchema(),
-			consts.FieldAuthLoginAzure:     AuthLoginAzureSchema(),
-			consts.FieldAuthLoginCert:      AuthLoginCertSchema(),
-			consts.FieldAuthLoginGCP:       AuthLoginGCPSchema(),
-			consts.FieldAuthLoginGeneric:   AuthLoginGenericSchema(),
-			consts.FieldAuthLoginJWT:       AuthLoginJWTSchema(),
-			consts.FieldAuthLoginKerberos:  AuthLoginKerberosSchema(),
-			consts.FieldAuthLoginOCI:       AuthLoginOCISchema(),
-			consts.FieldAuthLoginOIDC:      AuthLoginOIDCSchema(),
-			consts.FieldAuthLoginRadius:    AuthLoginRadiusSchema(),
-			consts.FieldAuthLoginTokenFile: AuthLoginTokenFileSchema(),
-			consts.FieldAuthLoginUserpass:  AuthLoginUserpassSchema(),
+			consts.FieldCluster: ClusterSchema(),
 		},
 	}
+
+	for k, v := range authLoginBlocks() {
+		resp.Schema.Blocks[k] = v
+	}
 }
 
 // Configure handles the configuration of any provider-level data or clients.
@@ -230,6 +265,8 @@ func (p *fwprovider) Configure(ctx context.Context, req provider.ConfigureReques
 	resp.DataSourceData = v
 	resp.ResourceData = v
 	resp.EphemeralResourceData = v
+	resp.ListResourceData = v
+	resp.ActionData = v
 }
 
 // Resources returns a slice of functions to instantiate each Resource
@@ -263,6 +300,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 		azure.NewAzureStaticRoleResource,
 		gcpkms.NewGCPKMSSecretBackendResource,
 		gcpkms.NewGCPKMSSecretBackendKeyResource,
+		transit.NewTransitKeyImportResource,
+		totp.NewTOTPSecretBackendKeyResource,
+		kv.NewKVSecretV2MetadataResource,
+		kv.NewKVSecretsV2TreeResource,
 		kmip.NewKMIPListenerResource,
 		kmip.NewKMIPCAGeneratedResource,
 		kmip.NewKMIPCAImportedResource,
@@ -275,6 +316,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 		pki_external_ca.NewPKIExternalCAOrderChallengeFulfilledResource,
 		pki_external_ca.NewPKIExternalCAOrderCertificateResource,
 		sys.NewActivationFlagsResource,
+		sys.NewReplicationPrimaryResource,
+		sys.NewReplicationSecondaryResource,
+		sys.NewReplicationPerformancePathsFilterResource,
 		keymgmt.NewKeyResource,
 		keymgmt.NewAWSKMSResource,
 		keymgmt.NewAzureKMSResource,
@@ -287,11 +331,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 		kerberosauth.NewKerberosAuthBackendConfigResource,
 		kerberosauth.NewKerberosAuthBackendLDAPConfigResource,
 		kerberosauth.NewKerberosAuthBackendGroupResource,
-	}, testResources()...)
+	}, append(generatedResources(), testResources()...)...)
 }
 
 func (p *fwprovider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{
+	return append([]func() ephemeral.EphemeralResource{
 		ephemeralsecrets.NewKVV2EphemeralSecretResource,
 		ephemeralsecrets.NewDBEphemeralSecretResource,
 		ephemeralsecrets.NewGenericEphemeralSecretResource,
@@ -315,8 +359,17 @@ func (p *fwprovider) EphemeralResources(_ context.Context) []func() ephemeral.Ep
 		gcpkms.NewGCPKMSDecryptEphemeralResource,
 		gcpkms.NewGCPKMSReencryptEphemeralResource,
 		gcpkms.NewGCPKMSSignEphemeralResource,
+		transit.NewTransitRewrapEphemeralResource,
+		transit.NewTransitDataKeyEphemeralResource,
+		transit.NewTransitHMACEphemeralResource,
+		transit.NewTransitBackupEphemeralResource,
+		ssh.NewSSHSecretBackendIssueEphemeralResource,
+		ssh.NewSSHSecretBackendOTPEphemeralResource,
+		sys.NewUnwrapEphemeralResource,
+		sys.NewReplicationSecondaryTokenEphemeralResource,
+		totp.NewTOTPSecretBackendCodeEphemeralResource,
 		kerberosauth.NewKerberosAuthBackendLoginEphemeralResource,
-	}
+	}, generatedEphemeralResources()...)
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -325,11 +378,101 @@ func (p *fwprovider) EphemeralResources(_ context.Context) []func() ephemeral.Ep
 // The data source type name is determined by the DataSource implementing
 // the Metadata method. All data sources must have unique names.
 func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return append([]func() datasource.DataSource{
 		sys.NewActivationFlagsDataSource,
 		pki_external_ca.NewPKIExternalCAOrderChallengeDataSource,
 		gcpkms.NewGCPKMSVerifyDataSource,
+		transit.NewTransitWrappingKeyDataSource,
+		pki.NewPKISecretBackendCRLDataSource,
+		pki.NewPKISecretBackendOCSPDataSource,
 		sys.NewPluginRuntimesDataSource,
+		sys.NewLeasesDataSource,
+		sys.NewWrappingLookupDataSource,
+		sys.NewReplicationStatusDataSource,
+		sys.NewCapabilitiesDataSource,
+		sys.NewPolicyEvaluationDataSource,
 		config.NewSysConfigCORSDataSource,
+	}, generatedDataSources()...)
+}
+
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function name is determined by the Function implementing the Metadata
+// method. All functions must have unique names.
+func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		sys.NewPolicyEncodeFunction,
+		sys.NewPolicyDecodeFunction,
+		sys.NewPolicyCapabilitiesFunction,
+	}
+}
+
+// ListResources returns a slice of functions to instantiate each ListResource
+// implementation.
+//
+// The list resource type name must match the type name of the managed
+// resource it lists.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	return p.withSDKv2Resources(ctx, []func() list.ListResource{
+		sys.NewPolicyListResource,
+		sys.NewAuthBackendListResource,
+		sys.NewMountListResource,
+		identity.NewEntityListResource,
+		identity.NewGroupListResource,
+		pki.NewPKISecretBackendRoleListResource,
+		kv.NewKVSecretV2ListResource,
+	})
+}
+
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// The action type name is determined by the Action implementing the Metadata
+// method. All actions must have unique names.
+func (p *fwprovider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		rotate.NewAWSRotateRootAction,
+		rotate.NewAzureRotateRootAction,
+		rotate.NewDatabaseRotateRootAction,
+		rotate.NewGCPRotateRootAction,
+		rotate.NewLDAPRotateRootAction,
+		rotate.NewLDAPStaticRoleRotateAction,
+		rotate.NewTerraformCloudRotateRootAction,
+		rotate.NewOSAccountRotateAction,
+		pki.NewPKISecretBackendTidyAction,
+		pki.NewPKISecretBackendCRLRotateAction,
+		ssh.NewSSHSecretBackendOTPVerifyAction,
+		sys.NewLeasesRevokePrefixAction,
+		kv.NewKVSecretV2DeleteVersionsAction,
+		kv.NewKVSecretV2UndeleteVersionsAction,
+		kv.NewKVSecretV2DestroyVersionsAction,
+	}
+}
+
+// withSDKv2Resources wraps the list resource constructors so that list
+// resources for SDKv2 managed resources are given the SDKv2 resource they
+// list.
+func (p *fwprovider) withSDKv2Resources(ctx context.Context, fs []func() list.ListResource) []func() list.ListResource {
+	primary, ok := p.Primary.(interface{ SchemaProvider() *sdkschema.Provider })
+	if !ok {
+		return fs
 	}
+	resources := primary.SchemaProvider().ResourcesMap
+
+	result := make([]func() list.ListResource, 0, len(fs))
+	for _, f := range fs {
+		result = append(result, func() list.ListResource {
+			r := f()
+			if v, ok := r.(interface{ SetSDKv2Resource(*sdkschema.Resource) }); ok {
+				var resp resource.MetadataResponse
+				r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "vault"}, &resp)
+				v.SetSDKv2Resource(resources[resp.TypeName])
+			}
+
+			return r
+		})
+	}
+
+	return result
 }
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// NewIdentity returns the resource identity for resources that are imported
+// by their ID. The identity consists of the resource ID and the namespace it
+// was created in.
+func NewIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				consts.FieldID: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The ID of the resource.",
+				},
+				consts.FieldNamespace: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "Target namespace. (requires Enterprise)",
+				},
+			}
+		},
+	}
+}
+
+// SetIdentity sets the resource identity from the resource ID and namespace.
+// It should be called from the Read function of any resource that was
+// configured with NewIdentity.
+func SetIdentity(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	if err := identity.Set(consts.FieldID, d.Id()); err != nil {
+		return fmt.Errorf("error setting identity %q: %w", consts.FieldID, err)
+	}
+
+	if err := identity.Set(consts.FieldNamespace, d.Get(consts.FieldNamespace)); err != nil {
+		return fmt.Errorf("error setting identity %q: %w", consts.FieldNamespace, err)
+	}
+
+	return nil
+}
+
+// ImportStatePassthroughWithIdentity imports a resource by its ID, or by the
+// identity set with NewIdentity. When importing by identity, the namespace
+// is taken from the identity rather than the environment.
+func ImportStatePassthroughWithIdentity(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != "" {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, err
+	}
+
+	id, ok := identity.GetOk(consts.FieldID)
+	if !ok {
+		return nil, fmt.Errorf("expected identity to contain %q", consts.FieldID)
+	}
+	d.SetId(id.(string))
+
+	if ns, ok := identity.GetOk(consts.FieldNamespace); ok {
+		if err := d.Set(consts.FieldNamespace, ns); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
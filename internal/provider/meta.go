@@ -4,9 +4,11 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
@@ -70,7 +72,18 @@ type ProviderMeta struct {
 	resourceData *schema.ResourceData
 	clientCache  map[string]*api.Client
 	vaultVersion *version.Version
-	mu           sync.RWMutex
+	// clusters are the additional Vault servers configured on the provider,
+	// keyed by name.
+	clusters map[string]*ProviderMeta
+	// cluster is the name of the cluster, empty for the provider's own
+	// Vault server.
+	cluster string
+	// tokenErr is set when the token expired and could not be replaced.
+	tokenErr error
+	// tokenWarnings are raised by the token renewer, and are returned by the
+	// next call to TokenDiagnostics.
+	tokenWarnings diag.Diagnostics
+	mu            sync.RWMutex
 }
 
 // GetClient returns the providers default Vault client.
@@ -204,6 +217,9 @@ func (p *ProviderMeta) setClient() error {
 	clientConfig := api.DefaultConfig()
 
 	addr := GetResourceDataStr(d, consts.FieldAddress, api.EnvVaultAddress, "")
+	if addr == "" {
+		addr = os.Getenv(api.EnvVaultAgentAddr)
+	}
 	if addr == "" {
 		return fmt.Errorf("failed to configure Vault address")
 	}
@@ -230,10 +246,34 @@ func (p *ProviderMeta) setClient() error {
 		return fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
+	if socket, ok := strings.CutPrefix(addr, "unix://"); ok {
+		// The Vault API client only supports unix domain sockets on an
+		// unwrapped *http.Transport, so the socket is configured here, before
+		// the transport is wrapped below.
+		if err := setUnixSocket(clientConfig, socket); err != nil {
+			return err
+		}
+	}
+
+	transportOpts := helper.DefaultTransportOptions()
+	if GetResourceDataBool(d, consts.FieldEnableReadCache, consts.EnvVarEnableReadCache, false) {
+		transportOpts.ReadCache = helper.NewReadCache()
+	}
+
+	requestsPerSecond := GetResourceDataFloat(d, consts.FieldMaxRequestsPerSecond, consts.EnvVarMaxRequestsPerSecond, 0)
+	maxConcurrentRequests := GetResourceDataInt(d, consts.FieldMaxConcurrentRequests, consts.EnvVarMaxConcurrentRequests, 0)
+	if requestsPerSecond > 0 || maxConcurrentRequests > 0 {
+		transportOpts.RateLimiter = helper.NewRateLimiter(
+			requestsPerSecond,
+			GetResourceDataInt(d, consts.FieldMaxRequestsBurst, consts.EnvVarMaxRequestsBurst, 0),
+			maxConcurrentRequests,
+		)
+	}
+
 	clientConfig.HttpClient.Transport = helper.NewTransport(
 		"Vault",
 		clientConfig.HttpClient.Transport,
-		helper.DefaultTransportOptions(),
+		transportOpts,
 	)
 
 	// enable ReadYourWrites to support read-after-write on Vault Enterprise
@@ -273,7 +313,9 @@ func (p *ProviderMeta) setClient() error {
 
 	client.SetMaxRetries(GetResourceDataInt(d, "max_retries", "VAULT_MAX_RETRIES", DefaultMaxHTTPRetries))
 
-	MaxHTTPRetriesCCC = GetResourceDataInt(d, "max_retries_ccc", "VAULT_MAX_RETRIES_CCC", DefaultMaxHTTPRetriesCCC)
+	if p.cluster == "" {
+		MaxHTTPRetriesCCC = p.GetMaxHTTPRetriesCCC()
+	}
 
 	// Set the namespace to the requested namespace, if provided
 	namespace := GetResourceDataStr(d, consts.FieldNamespace, "VAULT_NAMESPACE", "")
@@ -283,37 +325,53 @@ func (p *ProviderMeta) setClient() error {
 		return err
 	}
 
-	var token string
-	if authLogin != nil {
-		// the clone is only used to auth to Vault
-		clone, err := client.Clone()
-		if err != nil {
-			return err
-		}
-
-		if clone.Token() != "" {
+	if _, ok := authLogin.(*AuthLoginAgent); ok {
+		// The Vault Agent or Vault Proxy adds its auto-auth token to every
+		// request and manages the token's lifecycle, so there is no token to
+		// look up, renew or derive a child token from.
+		if client.Token() != "" {
 			log.Printf("[WARN] A vault token was set from the runtime environment, "+
-				"clearing it for auth_login method %q", authLogin.Method())
-			clone.ClearToken()
+				"clearing it for %q", consts.FieldAuthLoginAgent)
+			client.ClearToken()
 		}
 
-		if ns, ok := authLogin.Namespace(); ok {
-			// the namespace configured on the auth_login takes precedence over the provider's
-			// for authentication only.
-			log.Printf("[DEBUG] Setting Auth Login namespace to %q, use_root_namespace=%t", ns, ns == "")
-			clone.SetNamespace(ns)
-		} else if namespace != "" {
-			// authenticate to the engine in the provider's namespace
-			log.Printf("[DEBUG] Setting Auth Login namespace to %q from provider configuration", namespace)
-			clone.SetNamespace(namespace)
+		if namespace != "" {
+			if err := d.Set(consts.FieldNamespace, namespace); err != nil {
+				return fmt.Errorf("failed to set namespace on provider: %w", err)
+			}
+			log.Printf("[DEBUG] Setting namespace on client to %q", namespace)
+			client.SetNamespace(namespace)
 		}
 
-		secret, err := authLogin.Login(clone)
+		log.Printf("[INFO] Using the auto-auth token of the Vault Agent at %q", addr)
+		p.client = client
+		return nil
+	}
+
+	var renewer *tokenRenewer
+	if GetResourceDataBool(d, consts.FieldRenewToken, consts.EnvVarRenewToken, false) {
+		// the renewer needs the client as it was before authentication,
+		// so that it can authenticate again.
+		authClient, err := client.Clone()
 		if err != nil {
 			return err
 		}
 
-		token = secret.Auth.ClientToken
+		renewer = &tokenRenewer{
+			meta:       p,
+			d:          d,
+			authClient: authClient,
+			authLogin:  authLogin,
+			namespace:  namespace,
+		}
+	}
+
+	var token string
+	if authLogin != nil {
+		token, err = authLoginToken(client, authLogin, namespace)
+		if err != nil {
+			return err
+		}
 	} else {
 		// try and get the token from the config or token helper
 		token, err = GetToken(d)
@@ -346,6 +404,12 @@ func (p *ProviderMeta) setClient() error {
 	}
 
 	skipChildToken := GetResourceDataBool(d, consts.FieldSkipChildToken, consts.EnvVarSkipChildToken, false)
+	if renewer != nil {
+		renewer.parentToken = client.Token()
+		renewer.tokenNamespace = tokenNamespace
+		renewer.childToken = !skipChildToken
+	}
+
 	if !skipChildToken {
 		// a child token is always created in the namespace of the parent token.
 		token, err = createChildToken(d, client, tokenNamespace)
@@ -384,10 +448,70 @@ func (p *ProviderMeta) setClient() error {
 		client.SetNamespace(namespace)
 	}
 
+	if renewer != nil {
+		if err := renewer.start(client); err != nil {
+			return err
+		}
+	}
+
 	p.client = client
 	return nil
 }
 
+// setUnixSocket configures the client to connect to Vault, or to a Vault
+// Agent or Vault Proxy, through the unix domain socket at socket.
+func setUnixSocket(clientConfig *api.Config, socket string) error {
+	transport, ok := clientConfig.HttpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unix domain sockets are not supported with transport %T",
+			clientConfig.HttpClient.Transport)
+	}
+
+	transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, "unix", socket)
+	}
+
+	// the host is ignored, since all connections are made to the socket.
+	clientConfig.Address = "http://localhost"
+
+	return nil
+}
+
+// authLoginToken authenticates to Vault with the configured auth_login method,
+// and returns the resulting token. The provided client is not modified.
+func authLoginToken(client *api.Client, authLogin AuthLogin, namespace string) (string, error) {
+	// the clone is only used to auth to Vault
+	clone, err := client.Clone()
+	if err != nil {
+		return "", err
+	}
+
+	if clone.Token() != "" {
+		log.Printf("[WARN] A vault token was set from the runtime environment, "+
+			"clearing it for auth_login method %q", authLogin.Method())
+		clone.ClearToken()
+	}
+
+	if ns, ok := authLogin.Namespace(); ok {
+		// the namespace configured on the auth_login takes precedence over the provider's
+		// for authentication only.
+		log.Printf("[DEBUG] Setting Auth Login namespace to %q, use_root_namespace=%t", ns, ns == "")
+		clone.SetNamespace(ns)
+	} else if namespace != "" {
+		// authenticate to the engine in the provider's namespace
+		log.Printf("[DEBUG] Setting Auth Login namespace to %q from provider configuration", namespace)
+		clone.SetNamespace(namespace)
+	}
+
+	secret, err := authLogin.Login(clone)
+	if err != nil {
+		return "", err
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
 func (p *ProviderMeta) setVaultVersion() error {
 	if p.vaultVersion != nil {
 		return nil
@@ -428,9 +552,59 @@ func (p *ProviderMeta) getClient() (*api.Client, error) {
 		return nil, err
 	}
 
+	if p.tokenErr != nil {
+		return nil, p.tokenErr
+	}
+
 	return p.client, nil
 }
 
+// setTokenErr causes all subsequent requests for a client to fail with err.
+func (p *ProviderMeta) setTokenErr(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tokenErr = err
+}
+
+// AddTokenWarning adds a warning to be returned by TokenDiagnostics.
+func (p *ProviderMeta) AddTokenWarning(summary, detail string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tokenWarnings = append(p.tokenWarnings, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// TokenDiagnostics returns the warnings about the provider's token that were
+// raised in the background since the last call, e.g. when the token reached
+// its max TTL. Each warning is only returned once.
+func (p *ProviderMeta) TokenDiagnostics() diag.Diagnostics {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	diags := p.tokenWarnings
+	p.tokenWarnings = nil
+	for _, c := range p.clusters {
+		diags = append(diags, c.TokenDiagnostics()...)
+	}
+
+	return diags
+}
+
+// GetMaxHTTPRetriesCCC returns the maximum number of retries for Client
+// Controlled Consistency related operations on the Vault server of p.
+func (p *ProviderMeta) GetMaxHTTPRetriesCCC() int {
+	if p.resourceData == nil {
+		return MaxHTTPRetriesCCC
+	}
+
+	return GetResourceDataInt(p.resourceData, consts.FieldMaxRetriesCCC, "VAULT_MAX_RETRIES_CCC", DefaultMaxHTTPRetriesCCC)
+}
+
 // NewProviderMeta sets up the Provider to service Vault requests.
 // It is meant to be used as a schema.ConfigureFunc.
 func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
@@ -438,8 +612,14 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("nil ResourceData provided")
 	}
 
+	clusters, err := newClusterMetas(d)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ProviderMeta{
 		resourceData: d,
+		clusters:     clusters,
 	}, nil
 }
 
@@ -467,18 +647,57 @@ func warnMinTokenTTL(tokenInfo *api.Secret) {
 
 // GetClient is meant to be called from a schema.Resource function.
 // It ensures that the returned api.Client's matches the resource's configured
-// namespace. The value for the namespace is resolved from any of string,
-// *schema.ResourceData, *schema.ResourceDiff, or *terraform.InstanceState.
+// cluster and namespace. The value for the namespace is resolved from any of
+// string, *schema.ResourceData, *schema.ResourceDiff, or
+// *terraform.InstanceState. The cluster is never resolved from a string.
 func GetClient(i interface{}, meta interface{}) (*api.Client, error) {
+	p, ns, err := getResourceMeta(i, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if ns == "" {
+		// in order to import namespaced resources the user must provide
+		// the namespace from an environment variable.
+		ns = os.Getenv(consts.EnvVarVaultNamespaceImport)
+		if ns != "" {
+			log.Printf("[DEBUG] Value for %q set from environment", consts.FieldNamespace)
+		}
+	}
+
+	if ns != "" {
+		return p.GetNSClient(ns)
+	}
+
+	return p.GetClient()
+}
+
+// GetMaxHTTPRetriesCCC returns the maximum number of retries for Client
+// Controlled Consistency related operations on the resource's configured
+// cluster, which is resolved like in GetClient. It returns MaxHTTPRetriesCCC
+// when the cluster can't be resolved, GetClient returns the error in that
+// case.
+func GetMaxHTTPRetriesCCC(i interface{}, meta interface{}) int {
+	p, _, err := getResourceMeta(i, meta)
+	if err != nil {
+		return MaxHTTPRetriesCCC
+	}
+
+	return p.GetMaxHTTPRetriesCCC()
+}
+
+// getResourceMeta returns the ProviderMeta of the resource's configured
+// cluster, and the resource's configured namespace.
+func getResourceMeta(i interface{}, meta interface{}) (*ProviderMeta, string, error) {
 	var p *ProviderMeta
 	switch v := meta.(type) {
 	case *ProviderMeta:
 		p = v
 	default:
-		return nil, fmt.Errorf("meta argument must be a %T, not %T", p, meta)
+		return nil, "", fmt.Errorf("meta argument must be a %T, not %T", p, meta)
 	}
 
-	var ns string
+	var ns, cluster string
 	switch v := i.(type) {
 	case string:
 		ns = v
@@ -486,36 +705,48 @@ func GetClient(i interface{}, meta interface{}) (*api.Client, error) {
 		if v, ok := v.GetOk(consts.FieldNamespace); ok {
 			ns = v.(string)
 		}
+		if v, ok := v.GetOk(consts.FieldCluster); ok {
+			cluster = v.(string)
+		}
 	case *schema.ResourceDiff:
 		if v, ok := v.GetOk(consts.FieldNamespace); ok {
 			ns = v.(string)
 		}
+		if v, ok := v.GetOk(consts.FieldCluster); ok {
+			cluster = v.(string)
+		}
 	case *terraform.InstanceState:
 		ns = v.Attributes[consts.FieldNamespace]
+		cluster = v.Attributes[consts.FieldCluster]
 
 	// Allows tests that use new terraform-plugin-testing
 	// to successfully get a client. Only used in tests
 	// TODO unify the GetClient implementations between providers and directly pass in namespace
 	case *terraformplugintesting.InstanceState:
 		ns = v.Attributes[consts.FieldNamespace]
+		cluster = v.Attributes[consts.FieldCluster]
 	default:
-		return nil, fmt.Errorf("GetClient() called with unsupported type %T", v)
+		return nil, "", fmt.Errorf("GetClient() called with unsupported type %T", v)
 	}
 
-	if ns == "" {
-		// in order to import namespaced resources the user must provide
-		// the namespace from an environment variable.
-		ns = os.Getenv(consts.EnvVarVaultNamespaceImport)
-		if ns != "" {
-			log.Printf("[DEBUG] Value for %q set from environment", consts.FieldNamespace)
+	if _, ok := i.(string); !ok && cluster == "" {
+		// in order to import resources from a cluster the user must provide
+		// the cluster from an environment variable.
+		cluster = os.Getenv(consts.EnvVarVaultClusterImport)
+		if cluster != "" {
+			log.Printf("[DEBUG] Value for %q set from environment", consts.FieldCluster)
 		}
 	}
 
-	if ns != "" {
-		return p.GetNSClient(ns)
+	if cluster != "" {
+		c, err := p.GetClusterMeta(cluster)
+		if err != nil {
+			return nil, "", err
+		}
+		p = c
 	}
 
-	return p.GetClient()
+	return p, ns, nil
 }
 
 func GetClientDiag(i interface{}, meta interface{}) (*api.Client, diag.Diagnostics) {
@@ -667,6 +898,25 @@ func GetResourceDataInt(d *schema.ResourceData, field, env string, dv int) int {
 	return dv
 }
 
+// GetResourceDataFloat returns the value for a given ResourceData field
+// If the value is the zero value, then it checks the environment variable. If
+// the environment variable is empty, the default dv is returned
+func GetResourceDataFloat(d *schema.ResourceData, field, env string, dv float64) float64 {
+	if v, ok := d.Get(field).(float64); ok && v != 0 {
+		return v
+	}
+	if env != "" {
+		if s := os.Getenv(env); s != "" {
+			ret, err := strconv.ParseFloat(s, 64)
+			if err == nil {
+				return ret
+			}
+		}
+	}
+	// return default
+	return dv
+}
+
 // GetResourceDataBool returns the value for a given ResourceData field
 // If the value is the zero value, then it checks the environment variable. If
 // the environment variable is empty, the default dv is returned
@@ -684,6 +934,12 @@ func GetResourceDataBool(d *schema.ResourceData, field, env string, dv bool) boo
 		return dv
 	}
 
+	// fields that are not in the schema, e.g. provider fields that are not
+	// supported in a cluster block, always have their default value.
+	if !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(field) {
+		return dv
+	}
+
 	// If RawConfig exists, continue reading values from resource data
 	rawVal := rawConfig.GetAttr(field)
 
@@ -818,3 +818,71 @@ func TestGetResourceDataInt(t *testing.T) {
 		})
 	}
 }
+
+func TestGetResourceDataFloat(t *testing.T) {
+	tests := map[string]struct {
+		schemaData   map[string]interface{}
+		field        string
+		env          string
+		envValue     string
+		defaultValue float64
+		expected     float64
+	}{
+		"field: field present": {
+			schemaData: map[string]interface{}{
+				"test_field": 4.2,
+			},
+			field:        "test_field",
+			env:          "TEST_ENV",
+			envValue:     "10.5",
+			defaultValue: 20,
+			expected:     4.2,
+		},
+		"env: field missing, env present": {
+			schemaData:   map[string]interface{}{},
+			field:        "test_field",
+			env:          "TEST_ENV",
+			envValue:     "10.5",
+			defaultValue: 20,
+			expected:     10.5,
+		},
+		"default: field missing, env empty": {
+			schemaData:   map[string]interface{}{},
+			field:        "test_field",
+			env:          "TEST_ENV",
+			envValue:     "",
+			defaultValue: 20,
+			expected:     20,
+		},
+		"default: field missing, env invalid": {
+			schemaData:   map[string]interface{}{},
+			field:        "test_field",
+			env:          "TEST_ENV",
+			envValue:     "invalid_float",
+			defaultValue: 20,
+			expected:     20,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.env != "" {
+				t.Setenv(tt.env, tt.envValue)
+			}
+
+			testSchema := map[string]*schema.Schema{
+				"test_field": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, testSchema, tt.schemaData)
+
+			result := GetResourceDataFloat(d, tt.field, tt.env, tt.defaultValue)
+			if result != tt.expected {
+				t.Errorf("GetResourceDataFloat() got = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
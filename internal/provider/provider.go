@@ -133,6 +133,23 @@ func NewProvider(
 				Optional:    true,
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			consts.FieldMaxRequestsPerSecond: {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Description: "Maximum number of requests per second sent to Vault, " +
+					"shared by all namespaces. Defaults to unlimited.",
+			},
+			consts.FieldMaxRequestsBurst: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of requests sent to Vault in a single burst when max_requests_per_second is set.",
+			},
+			consts.FieldMaxConcurrentRequests: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Maximum number of requests to Vault that are in flight at the same time, " +
+					"shared by all namespaces. Defaults to unlimited.",
+			},
 			consts.FieldNamespace: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -159,11 +176,31 @@ func NewProvider(
 					},
 				},
 			},
+			consts.FieldCluster: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Additional Vault servers that resources and data sources " +
+					"can be managed in, selected with their cluster argument.",
+				Elem: GetClusterSchemaResource(),
+			},
 			consts.FieldSkipGetVaultVersion: {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Skip the dynamic fetching of the Vault server version.",
 			},
+			consts.FieldEnableReadCache: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Cache the responses of Vault read requests for the duration of " +
+					"a Terraform run. Cached responses are invalidated by writes to the same path.",
+			},
+			consts.FieldRenewToken: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Renew the provider's Vault token in the background for the duration of " +
+					"a Terraform run. Once the token can no longer be renewed, a new token is " +
+					"created with the configured auth_login method, or from the parent token when child tokens are enabled.",
+			},
 			consts.FieldVaultVersionOverride: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -218,7 +255,7 @@ func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
 	var errs error
 	resourceMap := make(map[string]*schema.Resource)
 	for k, desc := range descs {
-		resourceMap[k] = desc.Resource
+		resourceMap[k] = withTokenDiagnostics(desc.Resource)
 		if len(desc.PathInventory) == 0 {
 			errs = multierror.Append(errs, fmt.Errorf("%q needs its paths inventoried", k))
 		}
@@ -226,10 +263,72 @@ func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
 	return resourceMap, errs
 }
 
+// withTokenDiagnostics returns a copy of r whose CRUD functions also return
+// the warnings raised about the provider's token in the background, see
+// ProviderMeta.TokenDiagnostics. The CRUD functions that do not return
+// diagnostics are converted to their context aware counterparts.
+func withTokenDiagnostics(r *schema.Resource) *schema.Resource {
+	if r == nil {
+		return nil
+	}
+
+	res := *r
+	if f := res.Create; f != nil {
+		res.Create = nil
+		res.CreateContext = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, meta))
+		}
+	}
+	if f := res.Read; f != nil {
+		res.Read = nil
+		res.ReadContext = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, meta))
+		}
+	}
+	if f := res.Update; f != nil {
+		res.Update = nil
+		res.UpdateContext = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, meta))
+		}
+	}
+	if f := res.Delete; f != nil {
+		res.Delete = nil
+		res.DeleteContext = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, meta))
+		}
+	}
+
+	res.CreateContext = tokenDiagnosticsWrapper(res.CreateContext)
+	res.ReadContext = tokenDiagnosticsWrapper(res.ReadContext)
+	res.UpdateContext = tokenDiagnosticsWrapper(res.UpdateContext)
+	res.DeleteContext = tokenDiagnosticsWrapper(res.DeleteContext)
+
+	return &res
+}
+
+func tokenDiagnosticsWrapper[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if p, ok := meta.(*ProviderMeta); ok {
+			diags = append(diags, p.TokenDiagnostics()...)
+		}
+
+		return diags
+	}
+}
+
 // ReadWrapper provides common read operations to the wrapped schema.ReadFunc.
 func ReadWrapper(f schema.ReadFunc) schema.ReadFunc {
 	return func(d *schema.ResourceData, i interface{}) error {
-		if err := importNamespace(d); err != nil {
+		if err := importFromEnv(d, consts.FieldNamespace, consts.EnvVarVaultNamespaceImport); err != nil {
+			return err
+		}
+
+		if err := importFromEnv(d, consts.FieldCluster, consts.EnvVarVaultClusterImport); err != nil {
 			return err
 		}
 
@@ -240,7 +339,10 @@ func ReadWrapper(f schema.ReadFunc) schema.ReadFunc {
 // ReadContextWrapper provides common read operations to the wrapped schema.ReadContextFunc.
 func ReadContextWrapper(f schema.ReadContextFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-		if err := importNamespace(d); err != nil {
+		if err := importFromEnv(d, consts.FieldNamespace, consts.EnvVarVaultNamespaceImport); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := importFromEnv(d, consts.FieldCluster, consts.EnvVarVaultClusterImport); err != nil {
 			return diag.FromErr(err)
 		}
 		return f(ctx, d, i)
@@ -277,29 +379,32 @@ func UpdateContextWrapper(f schema.UpdateContextFunc, minVersion *version.Versio
 	}
 }
 
-func importNamespace(d *schema.ResourceData) error {
-	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+// importFromEnv sets field from the environment variable env, if the field is
+// not yet set in the state. It allows importing resources that are namespaced,
+// or that are managed in a provider cluster.
+func importFromEnv(d *schema.ResourceData, field, env string) error {
+	if v := os.Getenv(env); v != "" {
 		s := d.State()
-		var attemptNamespaceImport bool
+		var attemptImport bool
 		if s.Empty() {
 			// state does not yet exist or is empty
 			// import is acceptable
-			attemptNamespaceImport = true
+			attemptImport = true
 		} else {
-			// only import if namespace
+			// only import if the field
 			// is not already set in state
 			s.Lock()
 			defer s.Unlock()
-			_, ok := s.Attributes[consts.FieldNamespace]
-			attemptNamespaceImport = !ok
+			_, ok := s.Attributes[field]
+			attemptImport = !ok
 		}
-		if attemptNamespaceImport {
+		if attemptImport {
 			log.Printf(`[INFO] Environment variable %s set, `+
 				`attempting TF state import "%s=%s"`,
-				consts.EnvVarVaultNamespaceImport, consts.FieldNamespace, ns)
-			if err := d.Set(consts.FieldNamespace, ns); err != nil {
+				env, field, v)
+			if err := d.Set(field, v); err != nil {
 				return fmt.Errorf("failed to import %q, err=%w",
-					consts.EnvVarVaultNamespaceImport, err)
+					env, err)
 			}
 		}
 	}
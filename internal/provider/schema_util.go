@@ -121,6 +121,18 @@ func MustAddNamespaceSchema(d map[string]*schema.Schema) {
 	}
 }
 
+func GetClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.FieldCluster: {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Description: "Name of the provider cluster to manage the resource in. " +
+				"Defaults to the Vault server configured on the provider.",
+		},
+	}
+}
+
 func SecretsAuthMountDisableRemountResourceV0() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/helper"
+)
+
+// tokenRenewer keeps the provider's Vault token valid for the duration of a
+// Terraform run.
+//
+// The token is renewed with an api.LifetimeWatcher for as long as Vault allows
+// it. Once the token can no longer be renewed, either because it is not
+// renewable, as is the case for the provider's child tokens, or because it
+// reached its max TTL, a new token is created. The new token is obtained by
+// running the configured auth_login method again, and/or by creating a new
+// child token from the parent token. The new token is then set on all of the
+// provider's clients. Unless auth_login is used, the parent token is renewed
+// before a new child token is created from it, and no child token is created
+// once the parent token expires before it would.
+//
+// The token reaching its max TTL is surfaced as a warning diagnostic, see
+// ProviderMeta.TokenDiagnostics.
+type tokenRenewer struct {
+	meta *ProviderMeta
+	d    *schema.ResourceData
+	// authClient is the client that was used to authenticate to Vault.
+	authClient *api.Client
+	authLogin  AuthLogin
+	// namespace is the provider namespace that auth_login authenticates in.
+	namespace string
+	// parentToken is the token that child tokens are created from.
+	parentToken    string
+	tokenNamespace string
+	childToken     bool
+	// increment is the TTL that the token is renewed for.
+	increment time.Duration
+	// maxTTLReached is set once the token reached its max TTL.
+	maxTTLReached bool
+}
+
+// start begins renewing the token of client in the background.
+func (r *tokenRenewer) start(client *api.Client) error {
+	watcher, expireTime, err := r.newWatcher(client)
+	if err != nil {
+		return err
+	}
+
+	if watcher == nil {
+		log.Printf("[DEBUG] The Vault token does not expire, token renewal is not required")
+		return nil
+	}
+
+	go r.run(client, watcher, expireTime)
+
+	return nil
+}
+
+func (r *tokenRenewer) run(client *api.Client, watcher *api.LifetimeWatcher, expireTime time.Time) {
+	for {
+		go watcher.Start()
+		r.watch(watcher, &expireTime)
+		watcher.Stop()
+
+		if err := r.renewToken(client); err != nil {
+			log.Printf("[ERROR] The Vault token expires at %s, and could not be replaced: %s",
+				expireTime.Format(time.RFC3339), err)
+			r.meta.AddTokenWarning("Vault token cannot be replaced",
+				fmt.Sprintf("The Vault token expires at %s, and could not be replaced: %s. "+
+					"Any Vault requests made after that time will fail.", expireTime.Format(time.RFC3339), err))
+			time.AfterFunc(time.Until(expireTime), func() {
+				r.meta.setTokenErr(fmt.Errorf("the Vault token expired at %s, and could not be replaced, err=%w",
+					expireTime.Format(time.RFC3339), err))
+			})
+			return
+		}
+
+		var err error
+		watcher, expireTime, err = r.newWatcher(client)
+		if err != nil {
+			log.Printf("[ERROR] Failed to renew the new Vault token: %s", err)
+			return
+		}
+
+		if watcher == nil {
+			return
+		}
+	}
+}
+
+// watch blocks until the watcher is done renewing the token.
+func (r *tokenRenewer) watch(watcher *api.LifetimeWatcher, expireTime *time.Time) {
+	for {
+		select {
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				log.Printf("[WARN] Failed to renew the Vault token: %s", err)
+			}
+			return
+		case renewal := <-watcher.RenewCh():
+			if renewal.Secret == nil || renewal.Secret.Auth == nil {
+				continue
+			}
+
+			ttl := time.Duration(renewal.Secret.Auth.LeaseDuration) * time.Second
+			*expireTime = renewal.RenewedAt.Add(ttl)
+			log.Printf("[DEBUG] Renewed the Vault token, expires at %s", expireTime.Format(time.RFC3339))
+
+			if ttl < r.increment && !r.canCreateToken() && !r.maxTTLReached {
+				r.maxTTLReached = true
+				log.Printf("[WARN] The Vault token reached its max TTL and expires at %s, "+
+					"any Vault requests made after that time will fail", expireTime.Format(time.RFC3339))
+				r.meta.AddTokenWarning("Vault token reached its max TTL",
+					fmt.Sprintf("The Vault token reached its max TTL and expires at %s, any Vault "+
+						"requests made after that time will fail. Configure auth_login, or allow "+
+						"the provider to create child tokens, so that the token can be replaced.",
+						expireTime.Format(time.RFC3339)))
+			}
+		}
+	}
+}
+
+// renewToken replaces the token of the provider's clients with a new token.
+func (r *tokenRenewer) renewToken(client *api.Client) error {
+	if !r.canCreateToken() {
+		return errors.New("the token cannot be renewed any further, and no " +
+			"auth_login method is configured to create a new token")
+	}
+
+	r.meta.mu.Lock()
+	defer r.meta.mu.Unlock()
+
+	if r.authLogin != nil {
+		log.Printf("[INFO] Authenticating to Vault with auth_login method %q to replace the token",
+			r.authLogin.Method())
+		token, err := authLoginToken(r.authClient, r.authLogin, r.namespace)
+		if err != nil {
+			return err
+		}
+		r.parentToken = token
+	} else if r.childToken {
+		if err := r.renewParentToken(); err != nil {
+			return err
+		}
+	}
+
+	token := r.parentToken
+	if r.childToken {
+		clone, err := r.authClient.Clone()
+		if err != nil {
+			return err
+		}
+		clone.SetToken(r.parentToken)
+
+		token, err = createChildToken(r.d, clone, r.tokenNamespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	client.SetToken(token)
+	for _, c := range r.meta.clientCache {
+		c.SetToken(token)
+	}
+
+	return nil
+}
+
+// renewParentToken renews the parent token, if it is renewable. It returns an
+// error if the parent token expires before a new child token would, since
+// Vault does not allow a child token to outlive its parent.
+func (r *tokenRenewer) renewParentToken() error {
+	// the TTL of the parent token changes as it is renewed, so its lookup
+	// must not be cached.
+	clone, err := helper.DisableReadCache(r.authClient)
+	if err != nil {
+		return err
+	}
+	clone.SetToken(r.parentToken)
+
+	tokenInfo, err := clone.Auth().Token().LookupSelf()
+	if err != nil {
+		return fmt.Errorf("failed to lookup parent token, err=%w", err)
+	}
+	if tokenInfo == nil {
+		return fmt.Errorf("no token information returned from parent token self lookup")
+	}
+
+	ttl, err := tokenInfo.TokenTTL()
+	if err != nil {
+		return err
+	}
+
+	if ttl == 0 {
+		// the parent token does not expire
+		return nil
+	}
+
+	renewable, err := tokenInfo.TokenIsRenewable()
+	if err != nil {
+		return err
+	}
+
+	if renewable {
+		secret, err := clone.Auth().Token().RenewSelf(int(r.increment.Seconds()))
+		if err != nil {
+			log.Printf("[WARN] Failed to renew the parent Vault token: %s", err)
+		} else if secret != nil && secret.Auth != nil {
+			ttl = time.Duration(secret.Auth.LeaseDuration) * time.Second
+			log.Printf("[DEBUG] Renewed the parent Vault token, expires in %s", ttl)
+		}
+	}
+
+	if ttl < r.increment {
+		return fmt.Errorf("the parent token expires in %s, before a new child token with a TTL of %s would",
+			ttl, r.increment)
+	}
+
+	return nil
+}
+
+func (r *tokenRenewer) canCreateToken() bool {
+	return r.authLogin != nil || r.childToken
+}
+
+// newWatcher returns an api.LifetimeWatcher for the client's token, along
+// with the time that the token expires at. A nil watcher is returned if the
+// token does not expire.
+func (r *tokenRenewer) newWatcher(client *api.Client) (*api.LifetimeWatcher, time.Time, error) {
+	// the TTL of the token changes as it is renewed, so its lookup must not
+	// be cached.
+	lookupClient, err := helper.DisableReadCache(client)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	lookupClient.SetToken(client.Token())
+
+	tokenInfo, err := lookupClient.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to lookup token, err=%w", err)
+	}
+	if tokenInfo == nil {
+		return nil, time.Time{}, fmt.Errorf("no token information returned from self lookup")
+	}
+
+	ttl, err := tokenInfo.TokenTTL()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if ttl == 0 {
+		return nil, time.Time{}, nil
+	}
+
+	renewable, err := tokenInfo.TokenIsRenewable()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	r.increment = ttl
+	watcher, err := client.NewLifetimeWatcher(&api.LifetimeWatcherInput{
+		Secret: &api.Secret{
+			Auth: &api.SecretAuth{
+				ClientToken:   client.Token(),
+				Renewable:     renewable,
+				LeaseDuration: int(ttl.Seconds()),
+			},
+		},
+		Increment: int(ttl.Seconds()),
+		// retry failed renewals until the token expires, and wait for
+		// non-renewable tokens to expire instead of failing immediately.
+		RenewBehavior: api.RenewBehaviorIgnoreErrors,
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to create token lifetime watcher, err=%w", err)
+	}
+
+	return watcher, time.Now().Add(ttl), nil
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTokenRenewer(t *testing.T) {
+	// tokens prefixed with "child" are non-renewable and expire after 2s,
+	// tokens prefixed with "expiring" are non-renewable and expire after 1s,
+	// all other tokens never expire.
+	var created atomic.Int64
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(api.AuthHeaderName)
+		var resp map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			if r.Header.Get("Cache-Control") != "no-cache" {
+				t.Errorf("expected the token lookup to bypass the read cache")
+			}
+
+			ttl := 0
+			if strings.HasPrefix(token, "child") {
+				ttl = 2
+			} else if strings.HasPrefix(token, "expiring") {
+				ttl = 1
+			}
+			resp = map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":        token,
+					"ttl":       ttl,
+					"renewable": false,
+					"policies":  []string{"default"},
+				},
+			}
+		case "/v1/auth/token/create":
+			if token != "parent" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			resp = map[string]interface{}{
+				"auth": map[string]interface{}{
+					"client_token":   fmt.Sprintf("child-%d", created.Add(1)),
+					"policies":       []string{"default"},
+					"lease_duration": 2,
+				},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Error(err)
+		}
+	}
+
+	config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(handler))
+	defer ln.Close()
+
+	newMeta := func(t *testing.T) *ProviderMeta {
+		t.Helper()
+		client, err := api.NewClient(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.SetCloneToken(true)
+		client.SetToken("child-0")
+
+		nsClient, err := client.Clone()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &ProviderMeta{
+			client: client,
+			clientCache: map[string]*api.Client{
+				"ns1": nsClient,
+			},
+			resourceData: schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{}),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		parentToken string
+		childToken  bool
+		wantErr     bool
+	}{
+		{
+			name:        "child-token",
+			parentToken: "parent",
+			childToken:  true,
+		},
+		{
+			name:        "no-token-creation",
+			parentToken: "parent",
+			childToken:  false,
+			wantErr:     true,
+		},
+		{
+			name:        "expiring-parent-token",
+			parentToken: "expiring-parent",
+			childToken:  true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMeta(t)
+			authClient, err := m.client.Clone()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := &tokenRenewer{
+				meta:        m,
+				d:           m.resourceData,
+				authClient:  authClient,
+				parentToken: tt.parentToken,
+				childToken:  tt.childToken,
+			}
+			if err := r.start(m.client); err != nil {
+				t.Fatal(err)
+			}
+
+			deadline := time.Now().Add(10 * time.Second)
+			for time.Now().Before(deadline) {
+				if tt.wantErr {
+					if _, err := m.GetClient(); err != nil {
+						if len(m.TokenDiagnostics()) == 0 {
+							t.Errorf("expected a warning diagnostic for the token")
+						}
+						return
+					}
+				} else if m.MustGetClient().Token() != "child-0" {
+					break
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+
+			if tt.wantErr {
+				t.Fatalf("expected an error from GetClient() after the token expired")
+			}
+
+			token := m.MustGetClient().Token()
+			if !strings.HasPrefix(token, "child-") || token == "child-0" {
+				t.Fatalf("expected a new child token, actual %q", token)
+			}
+
+			if actual := m.clientCache["ns1"].Token(); actual != token {
+				t.Errorf("expected namespaced client token %q, actual %q", token, actual)
+			}
+		})
+	}
+}
+
+func TestWithTokenDiagnostics(t *testing.T) {
+	var reads int
+	r := withTokenDiagnostics(&schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			reads++
+			return nil
+		},
+	})
+	if r.Read != nil || r.ReadContext == nil {
+		t.Fatalf("expected Read to be converted to ReadContext")
+	}
+
+	m := &ProviderMeta{}
+	m.AddTokenWarning("summary", "detail")
+
+	diags := r.ReadContext(context.Background(), nil, m)
+	if reads != 1 {
+		t.Errorf("expected 1 read, actual %d", reads)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != "summary" {
+		t.Errorf("expected the token warning, actual %v", diags)
+	}
+
+	if diags := r.ReadContext(context.Background(), nil, m); len(diags) != 0 {
+		t.Errorf("expected the token warning to be returned once, actual %v", diags)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// MustAddWrappingSchema adds the response-wrapping fields to the schema of a
+// data source that returns a secret.
+func MustAddWrappingSchema(r *schema.Resource) *schema.Resource {
+	MustAddSchema(r, map[string]*schema.Schema{
+		consts.FieldWrapTTL: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The TTL of the response-wrapping token, e.g. '5m'. If set, Vault returns a " +
+				"single-use wrapping_token instead of the secret, and the other computed fields are not set.",
+		},
+		consts.FieldWrappingToken: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The response-wrapping token. Only set when wrap_ttl is set.",
+		},
+		consts.FieldWrappingAccessor: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The accessor of the response-wrapping token. Only set when wrap_ttl is set.",
+		},
+	})
+
+	return r
+}
+
+// GetWrappingClient returns a clone of client that requests the wrapping of
+// all its responses when wrap_ttl is set, otherwise client.
+func GetWrappingClient(d *schema.ResourceData, client *api.Client) (*api.Client, error) {
+	wrapTTL := d.Get(consts.FieldWrapTTL).(string)
+	if wrapTTL == "" {
+		return client, nil
+	}
+
+	client, err := client.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetWrappingLookupFunc(func(_, _ string) string {
+		return wrapTTL
+	})
+
+	return client, nil
+}
+
+// SetWrapInfo sets the ID, the wrapping token and accessor from the wrapping
+// information of secret. It returns false if secret is not wrapped.
+func SetWrapInfo(d *schema.ResourceData, secret *api.Secret) (bool, error) {
+	if secret == nil || secret.WrapInfo == nil {
+		return false, nil
+	}
+
+	d.SetId(secret.WrapInfo.Accessor)
+	if err := d.Set(consts.FieldWrappingToken, secret.WrapInfo.Token); err != nil {
+		return true, err
+	}
+	if err := d.Set(consts.FieldWrappingAccessor, secret.WrapInfo.Accessor); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
@@ -21,6 +21,30 @@ const (
 	fieldOptions           = "options"
 )
 
+// writeOnlyField is the write-only field, and its version field, that sets
+// the value of a Vault field of a sync destination.
+type writeOnlyField struct {
+	field   string
+	version string
+}
+
+// writeOnlyFields maps the Vault fields of sync destinations to the
+// write-only fields that set them.
+var writeOnlyFields = map[string]writeOnlyField{
+	consts.FieldIdentityTokenAudience: {
+		field:   consts.FieldIdentityTokenAudienceWO,
+		version: consts.FieldIdentityTokenAudienceWOVersion,
+	},
+	consts.FieldIdentityTokenKey: {
+		field:   consts.FieldIdentityTokenKeyWO,
+		version: consts.FieldIdentityTokenKeyWOVersion,
+	},
+	consts.FieldToken: {
+		field:   consts.FieldTokenWO,
+		version: consts.FieldTokenWOVersion,
+	},
+}
+
 func SyncDestinationCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, typ string, writeFields, readFields []string) diag.Diagnostics {
 	return SyncDestinationCreateUpdateWithOptions(ctx, d, meta, typ, writeFields, readFields, nil)
 }
@@ -51,21 +75,10 @@ func SyncDestinationCreateUpdateWithOptions(ctx context.Context, d *schema.Resou
 			data[k] = v
 		}
 
-		if k == consts.FieldIdentityTokenAudience {
-			if d.IsNewResource() || d.HasChange(consts.FieldIdentityTokenAudienceWOVersion) {
-				// Use GetRawConfigAt for write-only fields
-				p := cty.GetAttrPath(consts.FieldIdentityTokenAudienceWO)
-				woVal, _ := d.GetRawConfigAt(p)
-				if !woVal.IsNull() {
-					data[k] = woVal.AsString()
-				}
-			}
-		}
-
-		if k == consts.FieldIdentityTokenKey {
-			if d.IsNewResource() || d.HasChange(consts.FieldIdentityTokenKeyWOVersion) {
+		if wo, ok := writeOnlyFields[k]; ok {
+			if d.IsNewResource() || d.HasChange(wo.version) {
 				// Use GetRawConfigAt for write-only fields
-				p := cty.GetAttrPath(consts.FieldIdentityTokenKeyWO)
+				p := cty.GetAttrPath(wo.field)
 				woVal, _ := d.GetRawConfigAt(p)
 				if !woVal.IsNull() {
 					data[k] = woVal.AsString()
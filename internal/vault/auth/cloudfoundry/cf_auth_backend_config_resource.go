@@ -158,7 +158,7 @@ func (r *CFAuthBackendConfigResource) Create(ctx context.Context, req resource.C
 		cfPassword = &v
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -191,7 +191,7 @@ func (r *CFAuthBackendConfigResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -237,7 +237,7 @@ func (r *CFAuthBackendConfigResource) Update(ctx context.Context, req resource.U
 		cfPassword = &v
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -270,7 +270,7 @@ func (r *CFAuthBackendConfigResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -304,6 +304,8 @@ func (r *CFAuthBackendConfigResource) ImportState(ctx context.Context, req resou
 		)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (r *CFAuthBackendConfigResource) path(mount string) string {
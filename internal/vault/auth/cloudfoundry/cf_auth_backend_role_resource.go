@@ -128,7 +128,7 @@ func (r *CFAuthBackendRoleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -178,7 +178,7 @@ func (r *CFAuthBackendRoleResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -216,7 +216,7 @@ func (r *CFAuthBackendRoleResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -266,7 +266,7 @@ func (r *CFAuthBackendRoleResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -307,6 +307,8 @@ func (r *CFAuthBackendRoleResource) ImportState(ctx context.Context, req resourc
 		)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (r *CFAuthBackendRoleResource) path(data *CFAuthBackendRoleModel) (string, error) {
@@ -123,7 +123,7 @@ func (r *CFAuthLoginEphemeralResource) Open(ctx context.Context, req ephemeral.O
 		data.Mount = types.StringValue("cf")
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
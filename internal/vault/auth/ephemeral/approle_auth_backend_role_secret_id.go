@@ -38,6 +38,7 @@ type ApproleAuthBackendRoleSecretIDEphemeralResource struct {
 type ApproleAuthBackendRoleSecretIDEphemeralModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Backend  types.String `tfsdk:"backend"`
@@ -103,6 +104,7 @@ func (r *ApproleAuthBackendRoleSecretIDEphemeralResource) Schema(_ context.Conte
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -125,7 +127,13 @@ func (r *ApproleAuthBackendRoleSecretIDEphemeralResource) Open(ctx context.Conte
 		data.Backend = types.StringValue("approle")
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -174,6 +182,14 @@ func (r *ApproleAuthBackendRoleSecretIDEphemeralResource) Open(ctx context.Conte
 		return
 	}
 
+	// A wrapped response only holds the wrapping token, and the accessor of
+	// the wrapped SecretID
+	if data.SetWrapInfo(secretResp) {
+		data.Accessor = types.StringValue(secretResp.WrapInfo.WrappedAccessor)
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil || secretResp.Data == nil {
 		resp.Diagnostics.AddError(
 			"Empty response from Vault",
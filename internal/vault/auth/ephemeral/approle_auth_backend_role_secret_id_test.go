@@ -86,3 +86,62 @@ provider "echo" {
 resource "echo" "test_approle" {}
 `, backend, roleName)
 }
+
+// TestAccApproleAuthBackendRoleSecretID_wrapped confirms that a response-wrapped
+// AppRole SecretID only returns its wrapping token.
+func TestAccApproleAuthBackendRoleSecretID_wrapped(t *testing.T) {
+	acctestutil.SkipTestAcc(t)
+	backend := acctest.RandomWithPrefix("approle")
+	roleName := acctest.RandomWithPrefix("role")
+
+	expectedTokenRegex, err := regexp.Compile("^hv?s\\.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedAccessorRegex, err := regexp.Compile("^[a-f0-9-]+$")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%s"
+}
+
+ephemeral "vault_approle_auth_backend_role_secret_id" "secret" {
+  backend   = vault_auth_backend.approle.path
+  role_name = vault_approle_auth_backend_role.role.role_name
+  mount_id  = vault_approle_auth_backend_role.role.id
+  wrap_ttl  = "5m"
+}
+
+provider "echo" {
+  data = ephemeral.vault_approle_auth_backend_role_secret_id.secret
+}
+
+resource "echo" "test_approle" {}
+`, backend, roleName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test_approle", tfjsonpath.New("data").AtMapKey(consts.FieldWrappingToken), knownvalue.StringRegexp(expectedTokenRegex)),
+					statecheck.ExpectKnownValue("echo.test_approle", tfjsonpath.New("data").AtMapKey(consts.FieldWrappingAccessor), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test_approle", tfjsonpath.New("data").AtMapKey(consts.FieldAccessor), knownvalue.StringRegexp(expectedAccessorRegex)),
+					statecheck.ExpectKnownValue("echo.test_approle", tfjsonpath.New("data").AtMapKey(consts.FieldSecretID), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -75,6 +75,7 @@ type TokenPrivateData struct {
 	TokenType string `json:"token_type"`
 	Wrapped   bool   `json:"wrapped"`
 	Namespace string `json:"namespace,omitempty"`
+	Cluster   string `json:"cluster,omitempty"`
 }
 
 // Schema defines this resource's schema which is the data that is available in
@@ -217,7 +218,7 @@ func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenReq
 	}
 
 	// Get Vault client
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -435,6 +436,7 @@ func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenReq
 		TokenType: tokenType,
 		Wrapped:   wrapped,
 		Namespace: data.Namespace.ValueString(),
+		Cluster:   data.Cluster.ValueString(),
 	}
 
 	privateBytes, err := json.Marshal(privateData)
@@ -477,7 +479,7 @@ func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseR
 	}
 
 	// Get Vault client
-	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
+	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace, privateData.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
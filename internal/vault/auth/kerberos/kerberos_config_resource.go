@@ -153,7 +153,7 @@ func (r *kerberosAuthBackendConfigResource) Update(ctx context.Context, req reso
 func (r *kerberosAuthBackendConfigResource) writeConfig(ctx context.Context, plan *kerberosAuthBackendConfigModel, config *kerberosAuthBackendConfigModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return diags
@@ -226,7 +226,7 @@ func (r *kerberosAuthBackendConfigResource) Read(ctx context.Context, req resour
 func (r *kerberosAuthBackendConfigResource) read(ctx context.Context, config *kerberosAuthBackendConfigModel) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), config.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), config.Namespace.ValueString(), config.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return false, diags
@@ -307,6 +307,8 @@ func (r *kerberosAuthBackendConfigResource) ImportState(ctx context.Context, req
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // configPath returns the Vault API path for Kerberos auth backend config
@@ -311,6 +311,8 @@ func (r *kerberosAuthBackendGroupResource) ImportState(ctx context.Context, req
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // getClientAndPath is a helper that gets the Vault client and constructs the group path.
@@ -318,7 +320,7 @@ func (r *kerberosAuthBackendGroupResource) ImportState(ctx context.Context, req
 func (r *kerberosAuthBackendGroupResource) getClientAndPath(ctx context.Context, plan *kerberosAuthBackendGroupModel) (*api.Client, string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return nil, "", diags
@@ -355,7 +355,7 @@ func (r *kerberosAuthBackendLDAPConfigResource) Create(ctx context.Context, req
 func (r *kerberosAuthBackendLDAPConfigResource) writeConfig(ctx context.Context, plan *kerberosAuthBackendLDAPConfigModel, config *kerberosAuthBackendLDAPConfigModel, state *kerberosAuthBackendLDAPConfigModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return diags
@@ -522,7 +522,7 @@ func (r *kerberosAuthBackendLDAPConfigResource) Read(ctx context.Context, req re
 func (r *kerberosAuthBackendLDAPConfigResource) read(ctx context.Context, data *kerberosAuthBackendLDAPConfigModel) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return false, diags
@@ -737,6 +737,8 @@ func (r *kerberosAuthBackendLDAPConfigResource) ImportState(ctx context.Context,
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // configPath returns the Vault API path for Kerberos LDAP config
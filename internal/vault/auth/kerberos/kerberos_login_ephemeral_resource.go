@@ -40,6 +40,7 @@ type kerberosAuthBackendLoginEphemeral struct {
 type kerberosPrivateData struct {
 	Accessor  string `json:"accessor"`
 	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
 }
 
 type kerberosAuthBackendLoginModel struct {
@@ -166,7 +167,7 @@ func (e *kerberosAuthBackendLoginEphemeral) Open(ctx context.Context, req epheme
 		return
 	}
 
-	c, err := client.GetClient(ctx, e.Meta(), config.Namespace.ValueString())
+	c, err := client.GetClient(ctx, e.Meta(), config.Namespace.ValueString(), config.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -297,6 +298,7 @@ func (e *kerberosAuthBackendLoginEphemeral) Open(ctx context.Context, req epheme
 		privateData := kerberosPrivateData{
 			Accessor:  secret.Auth.Accessor,
 			Namespace: config.Namespace.ValueString(),
+			Cluster:   config.Cluster.ValueString(),
 		}
 		privateDataJSON, err := json.Marshal(privateData)
 		if err != nil {
@@ -342,7 +344,7 @@ func (e *kerberosAuthBackendLoginEphemeral) Close(ctx context.Context, req ephem
 	}
 
 	// Get the Vault client with the appropriate namespace from private data
-	c, err := client.GetClient(ctx, e.Meta(), privateData.Namespace)
+	c, err := client.GetClient(ctx, e.Meta(), privateData.Namespace, privateData.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
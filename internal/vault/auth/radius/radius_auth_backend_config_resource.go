@@ -176,7 +176,7 @@ func (r *RadiusAuthBackendConfigResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -241,7 +241,7 @@ func (r *RadiusAuthBackendConfigResource) upsertConfig(ctx context.Context, data
 		return diags
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return diags
@@ -315,6 +315,8 @@ func (r *RadiusAuthBackendConfigResource) ImportState(ctx context.Context, req r
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // extractRadiusConfigMountFromID parses an import identifier in the form
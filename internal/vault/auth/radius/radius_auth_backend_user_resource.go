@@ -122,7 +122,7 @@ func (r *RadiusAuthBackendUserResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	vaultClient, _, _, userPath, diags := r.getClientAndUserData(ctx, data.Namespace.ValueString(), data.Mount, data.Username)
+	vaultClient, _, _, userPath, diags := r.getClientAndUserData(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), data.Mount, data.Username)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -172,7 +172,7 @@ func (r *RadiusAuthBackendUserResource) Update(ctx context.Context, req resource
 func (r *RadiusAuthBackendUserResource) upsertUser(ctx context.Context, data *RadiusAuthBackendUserModel, writeErr func(error) (string, string)) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	vaultClient, _, _, userPath, clientDiags := r.getClientAndUserData(ctx, data.Namespace.ValueString(), data.Mount, data.Username)
+	vaultClient, _, _, userPath, clientDiags := r.getClientAndUserData(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), data.Mount, data.Username)
 	diags.Append(clientDiags...)
 	if diags.HasError() {
 		return diags
@@ -204,7 +204,7 @@ func (r *RadiusAuthBackendUserResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	vaultClient, _, _, userPath, diags := r.getClientAndUserData(ctx, data.Namespace.ValueString(), data.Mount, data.Username)
+	vaultClient, _, _, userPath, diags := r.getClientAndUserData(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), data.Mount, data.Username)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -252,6 +252,8 @@ func (r *RadiusAuthBackendUserResource) ImportState(ctx context.Context, req res
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // userPath returns the Vault API path for RADIUS user
@@ -285,10 +287,10 @@ func extractRadiusUserMountAndNameFromID(id string) (string, string, error) {
 
 // getClientAndUserData returns a Vault client together with normalized mount,
 // username, and user path values used by the resource operations.
-func (r *RadiusAuthBackendUserResource) getClientAndUserData(ctx context.Context, namespace string, mount types.String, username types.String) (*api.Client, string, string, string, diag.Diagnostics) {
+func (r *RadiusAuthBackendUserResource) getClientAndUserData(ctx context.Context, namespace, cluster string, mount types.String, username types.String) (*api.Client, string, string, string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), namespace)
+	vaultClient, err := client.GetClient(ctx, r.Meta(), namespace, cluster)
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return nil, "", "", "", diags
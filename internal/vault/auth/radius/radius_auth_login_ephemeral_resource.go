@@ -40,6 +40,7 @@ const radiusPrivateDataKey = "radius_data"
 type radiusAuthLoginPrivateData struct {
 	Accessor  string `json:"accessor"`
 	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
 }
 
 // RadiusAuthLoginEphemeralModel describes the Terraform resource data model to match the
@@ -180,7 +181,7 @@ func (r *RadiusAuthLoginEphemeralResource) Open(ctx context.Context, req ephemer
 		data.Mount = types.StringValue("radius")
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -292,6 +293,7 @@ func (r *RadiusAuthLoginEphemeralResource) Open(ctx context.Context, req ephemer
 		privateData := radiusAuthLoginPrivateData{
 			Accessor:  loginResp.Auth.Accessor,
 			Namespace: data.Namespace.ValueString(),
+			Cluster:   data.Cluster.ValueString(),
 		}
 		privateDataJSON, err := json.Marshal(privateData)
 		if err != nil {
@@ -345,7 +347,7 @@ func (r *RadiusAuthLoginEphemeralResource) Close(ctx context.Context, req epheme
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
+	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace, privateData.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -132,7 +132,7 @@ func (s *SpiffeAuthConfigResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -180,7 +180,7 @@ func (s *SpiffeAuthConfigResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -214,7 +214,7 @@ func (s *SpiffeAuthConfigResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -279,6 +279,8 @@ func (s *SpiffeAuthConfigResource) ImportState(ctx context.Context, req resource
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (s *SpiffeAuthConfigResource) path(mount string) string {
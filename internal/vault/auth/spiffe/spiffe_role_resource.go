@@ -102,7 +102,7 @@ func (s *SpiffeAuthRoleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -149,7 +149,7 @@ func (s *SpiffeAuthRoleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -189,7 +189,7 @@ func (s *SpiffeAuthRoleResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -236,7 +236,7 @@ func (s *SpiffeAuthRoleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -276,6 +276,8 @@ func (s *SpiffeAuthRoleResource) ImportState(ctx context.Context, req resource.I
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (s *SpiffeAuthRoleResource) path(data *SpiffeAuthRoleModel) (string, error) {
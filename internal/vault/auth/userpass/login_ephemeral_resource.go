@@ -40,6 +40,7 @@ type UserpassAuthLoginEphemeralResource struct {
 type userpassPrivateData struct {
 	Accessor  string `json:"accessor"`
 	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
 }
 
 func getUserpassMount(mount types.String) string {
@@ -142,7 +143,7 @@ func (r *UserpassAuthLoginEphemeralResource) Open(ctx context.Context, req ephem
 
 	data.Mount = types.StringValue(getUserpassMount(data.Mount))
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -187,6 +188,7 @@ func (r *UserpassAuthLoginEphemeralResource) Open(ctx context.Context, req ephem
 		privateDataJSON, err := marshalUserpassPrivateData(userpassPrivateData{
 			Accessor:  auth.Accessor,
 			Namespace: data.Namespace.ValueString(),
+			Cluster:   data.Cluster.ValueString(),
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -223,7 +225,7 @@ func (r *UserpassAuthLoginEphemeralResource) Close(ctx context.Context, req ephe
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
+	vaultClient, err := client.GetClient(ctx, r.Meta(), privateData.Namespace, privateData.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -164,7 +164,7 @@ func (r *UserpassAuthUserResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	vaultClient, diags := r.getVaultClient(ctx, data.Namespace)
+	vaultClient, diags := r.getVaultClient(ctx, data.Namespace, data.Cluster)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -216,7 +216,7 @@ func (r *UserpassAuthUserResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -264,6 +264,8 @@ func (r *UserpassAuthUserResource) ImportState(ctx context.Context, req resource
 		)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // userPath builds the Userpass user endpoint and optional sub-endpoints.
@@ -326,7 +328,7 @@ func (r *UserpassAuthUserResource) upsertUser(ctx context.Context, data *Userpas
 		return diags
 	}
 
-	vaultClient, clientDiags := r.getVaultClient(ctx, data.Namespace)
+	vaultClient, clientDiags := r.getVaultClient(ctx, data.Namespace, data.Cluster)
 	diags.Append(clientDiags...)
 	if diags.HasError() {
 		return diags
@@ -349,8 +351,8 @@ func (r *UserpassAuthUserResource) upsertUser(ctx context.Context, data *Userpas
 }
 
 // getVaultClient returns a namespace-scoped Vault client for the resource operation.
-func (r *UserpassAuthUserResource) getVaultClient(ctx context.Context, namespace types.String) (*api.Client, diag.Diagnostics) {
-	vaultClient, err := client.GetClient(ctx, r.Meta(), namespace.ValueString())
+func (r *UserpassAuthUserResource) getVaultClient(ctx context.Context, namespace, cluster types.String) (*api.Client, diag.Diagnostics) {
+	vaultClient, err := client.GetClient(ctx, r.Meta(), namespace.ValueString(), cluster.ValueString())
 	if err != nil {
 		return nil, diag.Diagnostics{diag.NewErrorDiagnostic(errutil.ClientConfigureErr(err))}
 	}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccTransitRandom(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitRandomConfig(backend, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// 32 bytes encoded in base64 by default.
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("random_bytes"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`))),
+				},
+			},
+			{
+				Config: testAccTransitRandomConfig(backend, `
+  bytes  = 16
+  format = "hex"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("random_bytes"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccTransitRandomConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+ephemeral "vault_transit_random" "test" {
+  mount_id = vault_mount.transit.id
+  mount    = vault_mount.transit.path
+%s
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_random.test
+}
+
+resource "echo" "test" {}
+`, backend, extra)
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the list.ListResource interface
+var _ list.ListResourceWithRawV5Schemas = &EntityListResource{}
+
+// NewEntityListResource returns the implementation for this list resource
+func NewEntityListResource() list.ListResource {
+	return &EntityListResource{}
+}
+
+// EntityListResource lists the identity entities of a namespace
+type EntityListResource struct {
+	base.ListResourceWithSDKv2
+}
+
+func (r *EntityListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_entity"
+}
+
+func (r *EntityListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the identity entities in a namespace.",
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+func (r *EntityListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data base.BaseModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results, err := listByID(ctx, cli, "identity/entity/id")
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.ListResults(ctx, req, data.Namespace.ValueString(), data.Cluster.ValueString(), results)
+}
+
+// listByID lists the identity objects at path. The results are identified by
+// their ID, and named after the object's name.
+func listByID(ctx context.Context, cli *api.Client, path string) ([]base.ListResult, error) {
+	tflog.Debug(ctx, "Listing identity objects", map[string]any{consts.FieldPath: path})
+	resp, err := cli.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	keys, ok := resp.Data["keys"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for %q", resp.Data["keys"], "keys")
+	}
+	keyInfo, _ := resp.Data["key_info"].(map[string]interface{})
+
+	var results []base.ListResult
+	for _, k := range keys {
+		id, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for key", k)
+		}
+
+		name := id
+		if info, ok := keyInfo[id].(map[string]interface{}); ok {
+			if v, ok := info[consts.FieldName].(string); ok && v != "" {
+				name = v
+			}
+		}
+
+		results = append(results, base.ListResult{
+			ID:          id,
+			DisplayName: name,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the list.ListResource interface
+var _ list.ListResourceWithRawV5Schemas = &GroupListResource{}
+
+// NewGroupListResource returns the implementation for this list resource
+func NewGroupListResource() list.ListResource {
+	return &GroupListResource{}
+}
+
+// GroupListResource lists the identity groups of a namespace
+type GroupListResource struct {
+	base.ListResourceWithSDKv2
+}
+
+func (r *GroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_group"
+}
+
+func (r *GroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the identity groups in a namespace.",
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+func (r *GroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data base.BaseModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results, err := listByID(ctx, cli, "identity/group/id")
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.ListResults(ctx, req, data.Namespace.ValueString(), data.Cluster.ValueString(), results)
+}
@@ -102,7 +102,7 @@ func (r *AWSKMSResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -162,7 +162,7 @@ func (r *AWSKMSResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -195,7 +195,7 @@ func (r *AWSKMSResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, plan.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, plan.Namespace.ValueString(), plan.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -259,7 +259,7 @@ func (r *AWSKMSResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -304,6 +304,8 @@ func (r *AWSKMSResource) ImportState(ctx context.Context, req resource.ImportSta
 		tflog.Debug(ctx, fmt.Sprintf("Setting namespace from %s: %s", consts.EnvVarVaultNamespaceImport, ns))
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // APIPath returns the Vault API path for this KMS provider.
@@ -311,9 +313,9 @@ func (m *AWSKMSResourceModel) APIPath() string {
 	return BuildKMSPath(m.Mount.ValueString(), m.Name.ValueString())
 }
 
-// getVaultClient returns a Vault client for the given namespace, adding a diagnostic on error.
-func (r *AWSKMSResource) getVaultClient(ctx context.Context, namespace string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
-	cli, err := client.GetClient(ctx, r.Meta(), namespace)
+// getVaultClient returns a Vault client for the given namespace and cluster, adding a diagnostic on error.
+func (r *AWSKMSResource) getVaultClient(ctx context.Context, namespace, cluster string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
+	cli, err := client.GetClient(ctx, r.Meta(), namespace, cluster)
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return nil, false
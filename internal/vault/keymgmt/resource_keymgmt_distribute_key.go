@@ -119,7 +119,7 @@ func (r *DistributeKeyResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -171,7 +171,7 @@ func (r *DistributeKeyResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -209,7 +209,7 @@ func (r *DistributeKeyResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -260,6 +260,8 @@ func (r *DistributeKeyResource) ImportState(ctx context.Context, req resource.Im
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // APIPath returns the Vault API path for this key distribution.
@@ -267,9 +269,9 @@ func (m *DistributeKeyResourceModel) APIPath() string {
 	return BuildDistributeKeyPath(m.Mount.ValueString(), m.KMSName.ValueString(), m.KeyName.ValueString())
 }
 
-// getVaultClient returns a Vault client for the given namespace, adding a diagnostic on error.
-func (r *DistributeKeyResource) getVaultClient(ctx context.Context, namespace string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
-	cli, err := client.GetClient(ctx, r.Meta(), namespace)
+// getVaultClient returns a Vault client for the given namespace and cluster, adding a diagnostic on error.
+func (r *DistributeKeyResource) getVaultClient(ctx context.Context, namespace, cluster string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
+	cli, err := client.GetClient(ctx, r.Meta(), namespace, cluster)
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return nil, false
@@ -102,7 +102,7 @@ func (r *GCPKMSResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -161,7 +161,7 @@ func (r *GCPKMSResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -194,7 +194,7 @@ func (r *GCPKMSResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, plan.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, plan.Namespace.ValueString(), plan.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -258,7 +258,7 @@ func (r *GCPKMSResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -316,6 +316,8 @@ func (r *GCPKMSResource) ImportState(ctx context.Context, req resource.ImportSta
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // APIPath returns the Vault API path for this KMS provider.
@@ -323,9 +325,9 @@ func (m *GCPKMSResourceModel) APIPath() string {
 	return BuildKMSPath(m.Mount.ValueString(), m.Name.ValueString())
 }
 
-// getVaultClient returns a Vault client for the given namespace, adding a diagnostic on error.
-func (r *GCPKMSResource) getVaultClient(ctx context.Context, namespace string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
-	cli, err := client.GetClient(ctx, r.Meta(), namespace)
+// getVaultClient returns a Vault client for the given namespace and cluster, adding a diagnostic on error.
+func (r *GCPKMSResource) getVaultClient(ctx context.Context, namespace, cluster string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
+	cli, err := client.GetClient(ctx, r.Meta(), namespace, cluster)
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return nil, false
@@ -127,7 +127,7 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -190,7 +190,7 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -221,7 +221,7 @@ func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, plan.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, plan.Namespace.ValueString(), plan.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -268,7 +268,7 @@ func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), &resp.Diagnostics)
+	cli, ok := r.getVaultClient(ctx, data.Namespace.ValueString(), data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -313,6 +313,8 @@ func (r *KeyResource) ImportState(ctx context.Context, req resource.ImportStateR
 		tflog.Debug(ctx, fmt.Sprintf("Setting namespace from %s: %s", consts.EnvVarVaultNamespaceImport, ns))
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // APIPath returns the Vault API path for this key.
@@ -320,9 +322,9 @@ func (m *KeyResourceModel) APIPath() string {
 	return BuildKeyPath(m.Mount.ValueString(), m.Name.ValueString())
 }
 
-// getVaultClient returns a Vault client for the given namespace, adding a diagnostic on error.
-func (r *KeyResource) getVaultClient(ctx context.Context, namespace string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
-	cli, err := client.GetClient(ctx, r.Meta(), namespace)
+// getVaultClient returns a Vault client for the given namespace and cluster, adding a diagnostic on error.
+func (r *KeyResource) getVaultClient(ctx context.Context, namespace, cluster string, diags *diag.Diagnostics) (*vaultapi.Client, bool) {
+	cli, err := client.GetClient(ctx, r.Meta(), namespace, cluster)
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return nil, false
@@ -5,9 +5,7 @@ package alicloud
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -23,6 +21,7 @@ import (
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &AliCloudAccessCredentialsEphemeralResource{}
 var _ ephemeral.EphemeralResourceWithClose = &AliCloudAccessCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &AliCloudAccessCredentialsEphemeralResource{}
 
 // NewAliCloudAccessCredentialsEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -32,7 +31,7 @@ var NewAliCloudAccessCredentialsEphemeralResource = func() ephemeral.EphemeralRe
 
 // AliCloudAccessCredentialsEphemeralResource implements the methods that define this resource
 type AliCloudAccessCredentialsEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // AliCloudAccessCredentialsModel describes the Terraform resource data model to match the
@@ -40,6 +39,7 @@ type AliCloudAccessCredentialsEphemeralResource struct {
 type AliCloudAccessCredentialsModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Mount          types.String `tfsdk:"mount"`
@@ -116,6 +116,7 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Schema(_ context.Context, _
 		MarkdownDescription: "Provides an ephemeral resource to generate AliCloud credentials from Vault.",
 	}
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -133,7 +134,13 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Open(ctx context.Context, r
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -156,6 +163,12 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Open(ctx context.Context, r
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(sec) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var apiResp AliCloudAccessCredentialsAPIModel
 	if err := model.ToAPIModel(sec.Data, &apiResp); err != nil {
 		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
@@ -175,64 +188,8 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Open(ctx context.Context, r
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(sec.Renewable)
 
-	// Store lease information in private data for cleanup in Close
-	if sec.LeaseID != "" {
-		privateData, err := json.Marshal(AliCloudAccessCredentialsPrivateData{
-			LeaseID:   sec.LeaseID,
-			Namespace: data.Namespace.ValueString(),
-		})
-		if err != nil {
-			log.Printf("[WARN] Failed to marshal private data: %s", err)
-		} else {
-			resp.Private.SetKey(ctx, "lease_data", privateData)
-		}
-	}
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, sec, data.Namespace.ValueString(), data.Cluster.ValueString())
 
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
-
-// AliCloudAccessCredentialsPrivateData stores lease information for cleanup
-type AliCloudAccessCredentialsPrivateData struct {
-	LeaseID   string `json:"lease_id"`
-	Namespace string `json:"namespace"`
-}
-
-// Close revokes the credentials lease when the ephemeral resource is no longer needed
-func (r *AliCloudAccessCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
-	privateBytes, diags := req.Private.GetKey(ctx, "lease_data")
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// If no private data, nothing to clean up
-	if len(privateBytes) == 0 {
-		return
-	}
-
-	var privateData AliCloudAccessCredentialsPrivateData
-	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
-		log.Printf("[WARN] Failed to unmarshal private data: %s", err)
-		return
-	}
-
-	if privateData.LeaseID == "" {
-		// No lease to revoke
-		return
-	}
-
-	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
-	if err != nil {
-		resp.Diagnostics.AddError("Error configuring Vault client for revoke", err.Error())
-		return
-	}
-
-	// Attempt to revoke the lease
-	err = c.Sys().Revoke(privateData.LeaseID)
-	if err != nil {
-		// Log but do not fail resource close
-		log.Printf("[WARN] Failed to revoke lease %q: %s", privateData.LeaseID, err)
-	} else {
-		log.Printf("[DEBUG] Successfully revoked lease %q", privateData.LeaseID)
-	}
-}
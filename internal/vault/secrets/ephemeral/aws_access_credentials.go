@@ -20,6 +20,8 @@ import (
 )
 
 var _ ephemeral.EphemeralResource = &AWSAccessCredentialsEphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithClose = &AWSAccessCredentialsEphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &AWSAccessCredentialsEphemeralSecretResource{}
 
 var NewAWSAccessCredentialsEphemeralSecretResource = func() ephemeral.EphemeralResource {
 	return &AWSAccessCredentialsEphemeralSecretResource{}
@@ -27,7 +29,7 @@ var NewAWSAccessCredentialsEphemeralSecretResource = func() ephemeral.EphemeralR
 
 // AWSAccessCredentialsEphemeralSecretResource defines the method that defines this resource.
 type AWSAccessCredentialsEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // AWSAccessCredentialsEphemeralSecretModel describes the terraform resource data model to match the
@@ -35,6 +37,7 @@ type AWSAccessCredentialsEphemeralSecretResource struct {
 type AWSAccessCredentialsEphemeralSecretModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Mount          types.String `tfsdk:"mount"`
@@ -126,6 +129,7 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Schema(_ context.Context,
 		MarkdownDescription: "Provides an ephemeral resource to generate AWS credentials from Vault.",
 	}
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -142,7 +146,13 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Open(ctx context.Context,
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -205,6 +215,12 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Open(ctx context.Context,
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(sec) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var apiResp AWSAccessCredentialsAPIModel
 	if err := model.ToAPIModel(sec.Data, &apiResp); err != nil {
 		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
@@ -224,5 +240,8 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Open(ctx context.Context,
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(sec.Renewable)
 
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, sec, data.Namespace.ValueString(), data.Cluster.ValueString())
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
@@ -36,6 +36,7 @@ type AWSStaticAccessCredentialsEphemeralSecretResource struct {
 // resource schema.
 type AWSStaticAccessCredentialsEphemeralSecretModel struct {
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	Mount types.String `tfsdk:"mount"`
 	Name  types.String `tfsdk:"name"`
@@ -79,6 +80,7 @@ func (r *AWSStaticAccessCredentialsEphemeralSecretResource) Schema(_ context.Con
 		MarkdownDescription: "Provides an ephemeral resource to read AWS static credentials from Vault.",
 	}
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -96,7 +98,13 @@ func (r *AWSStaticAccessCredentialsEphemeralSecretResource) Open(ctx context.Con
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -115,6 +123,12 @@ func (r *AWSStaticAccessCredentialsEphemeralSecretResource) Open(ctx context.Con
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var apiResp AWSStaticAccessCredentialsAPIModel
 	if err := model.ToAPIModel(secret.Data, &apiResp); err != nil {
 		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
@@ -5,7 +5,6 @@ package ephemeralsecrets
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -52,6 +51,7 @@ var azureCloudConfigMap = map[string]cloud.Configuration{
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &AzureAccessCredentialsEphemeralResource{}
 var _ ephemeral.EphemeralResourceWithClose = &AzureAccessCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &AzureAccessCredentialsEphemeralResource{}
 
 // NewAzureAccessCredentialsEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -61,13 +61,7 @@ var NewAzureAccessCredentialsEphemeralResource = func() ephemeral.EphemeralResou
 
 // AzureAccessCredentialsEphemeralResource implements the methods that define this resource
 type AzureAccessCredentialsEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
-}
-
-// AzureAccessCredentialsPrivateData stores data needed for cleanup in Close
-type AzureAccessCredentialsPrivateData struct {
-	LeaseID   string `json:"lease_id"`
-	Namespace string `json:"namespace"`
+	base.EphemeralResourceWithLease
 }
 
 // AzureAccessCredentialsAPIModel describes the Vault API data model.
@@ -82,6 +76,7 @@ type AzureAccessCredentialsAPIModel struct {
 type AzureAccessCredentialsModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Backend                  types.String `tfsdk:"backend"`
@@ -186,6 +181,7 @@ func (r *AzureAccessCredentialsEphemeralResource) Schema(_ context.Context, _ ep
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -202,7 +198,13 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -281,6 +283,12 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	log.Printf("[DEBUG] Read %q from Vault", credsPath)
 
 	var apiResp AzureAccessCredentialsAPIModel
@@ -301,18 +309,8 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 	resp.Diagnostics.Append(md...)
 	data.Metadata = metaVal
 
-	// Store lease information in private data for cleanup in Close
-	if secret.LeaseID != "" {
-		privateData, err := json.Marshal(AzureAccessCredentialsPrivateData{
-			LeaseID:   secret.LeaseID,
-			Namespace: data.Namespace.ValueString(),
-		})
-		if err != nil {
-			log.Printf("[WARN] Failed to marshal private data: %s", err)
-		} else {
-			resp.Private.SetKey(ctx, "lease_data", privateData)
-		}
-	}
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, secret, data.Namespace.ValueString(), data.Cluster.ValueString())
 
 	// If we're not supposed to validate creds, we're done
 	if !data.ValidateCreds.ValueBool() {
@@ -484,46 +482,6 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
-// Close revokes the credentials lease when the ephemeral resource is no longer needed
-func (r *AzureAccessCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
-	privateBytes, diags := req.Private.GetKey(ctx, "lease_data")
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// If no private data, nothing to clean up
-	if len(privateBytes) == 0 {
-		return
-	}
-
-	var privateData AzureAccessCredentialsPrivateData
-	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
-		log.Printf("[WARN] Failed to unmarshal private data: %s", err)
-		return
-	}
-
-	if privateData.LeaseID == "" {
-		// No lease to revoke
-		return
-	}
-
-	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
-	if err != nil {
-		resp.Diagnostics.AddError("Error configuring Vault client for revoke", err.Error())
-		return
-	}
-
-	// Attempt to revoke the lease
-	err = c.Sys().Revoke(privateData.LeaseID)
-	if err != nil {
-		// Log but do not fail resource close
-		log.Printf("[WARN] Failed to revoke lease %q: %s", privateData.LeaseID, err)
-	} else {
-		log.Printf("[DEBUG] Successfully revoked lease %q", privateData.LeaseID)
-	}
-}
-
 func getAzureCloudConfigFromName(name string) (cloud.Configuration, error) {
 	if name == "" {
 		return cloud.AzurePublic, nil
@@ -33,6 +33,7 @@ type AzureStaticCredsEphemeralSecretResource struct {
 
 type AzureStaticCredsEphemeralSecretModel struct {
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	Backend         types.String `tfsdk:"backend"`
 	Role            types.String `tfsdk:"role"`
@@ -95,6 +96,7 @@ func (r *AzureStaticCredsEphemeralSecretResource) Schema(_ context.Context, _ ep
 		MarkdownDescription: "Provides an ephemeral resource to read Azure static credentials from Vault.",
 	}
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 func (r *AzureStaticCredsEphemeralSecretResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
@@ -108,7 +110,13 @@ func (r *AzureStaticCredsEphemeralSecretResource) Open(ctx context.Context, req
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -151,6 +159,12 @@ func (r *AzureStaticCredsEphemeralSecretResource) Open(ctx context.Context, req
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(sec) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var apiResp AzureStaticCredsAPIModel
 	if err := model.ToAPIModel(sec.Data, &apiResp); err != nil {
 		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
@@ -19,6 +19,8 @@ import (
 
 // Ensure the implementation satisfies the resource.ResourceWithConfigure interface
 var _ ephemeral.EphemeralResource = &DBEphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithClose = &DBEphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &DBEphemeralSecretResource{}
 
 // NewDBEphemeralSecretResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -28,7 +30,7 @@ var NewDBEphemeralSecretResource = func() ephemeral.EphemeralResource {
 
 // DBEphemeralSecretResource implements the methods that define this resource
 type DBEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // DBEphemeralSecretModel describes the Terraform resource data model to match the
@@ -36,6 +38,7 @@ type DBEphemeralSecretResource struct {
 type DBEphemeralSecretModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Mount             types.String `tfsdk:"mount"`
@@ -104,6 +107,7 @@ func (r *DBEphemeralSecretResource) Schema(_ context.Context, _ ephemeral.Schema
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -120,7 +124,13 @@ func (r *DBEphemeralSecretResource) Open(ctx context.Context, req ephemeral.Open
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -144,6 +154,12 @@ func (r *DBEphemeralSecretResource) Open(ctx context.Context, req ephemeral.Open
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var readResp DBEphemeralSecretAPIModel
 	err = model.ToAPIModel(secretResp.Data, &readResp)
 	if err != nil {
@@ -171,6 +187,9 @@ func (r *DBEphemeralSecretResource) Open(ctx context.Context, req ephemeral.Open
 		data.PrivateKeyType = types.StringValue(readResp.PrivateKeyType)
 	}
 
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, secretResp, data.Namespace.ValueString(), data.Cluster.ValueString())
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
@@ -41,6 +42,8 @@ func isGCPTokenGenerationError(err error) bool {
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &GCPOAuth2AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &GCPOAuth2AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &GCPOAuth2AccessTokenEphemeralResource{}
 
 // NewGCPOAuth2AccessTokenEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -50,7 +53,7 @@ var NewGCPOAuth2AccessTokenEphemeralResource = func() ephemeral.EphemeralResourc
 
 // GCPOAuth2AccessTokenEphemeralResource implements the methods that define this resource
 type GCPOAuth2AccessTokenEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // GCPOAuth2AccessTokenModel describes the Terraform resource data model to match the
@@ -58,6 +61,7 @@ type GCPOAuth2AccessTokenEphemeralResource struct {
 type GCPOAuth2AccessTokenModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Mount               types.String `tfsdk:"mount"`
@@ -138,6 +142,7 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Schema(_ context.Context, _ ephe
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -187,7 +192,21 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	// every read generates a new token, and it is retried until the
+	// account is ready, so it must never be cached.
+	c, err = helper.DisableReadCache(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -285,6 +304,12 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(vaultSecret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	log.Printf("[DEBUG] Generated GCP OAuth2 access token from %q", tokenPath)
 
 	// Extract token
@@ -314,5 +339,8 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Open(ctx context.Context, req ep
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(vaultSecret.Renewable)
 
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, vaultSecret, data.Namespace.ValueString(), data.Cluster.ValueString())
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
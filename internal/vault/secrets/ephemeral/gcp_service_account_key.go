@@ -23,6 +23,8 @@ import (
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &GCPServiceAccountKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &GCPServiceAccountKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &GCPServiceAccountKeyEphemeralResource{}
 
 // NewGCPServiceAccountKeyEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -32,7 +34,7 @@ var NewGCPServiceAccountKeyEphemeralResource = func() ephemeral.EphemeralResourc
 
 // GCPServiceAccountKeyEphemeralResource implements the methods that define this resource
 type GCPServiceAccountKeyEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // GCPServiceAccountKeyModel describes the Terraform resource data model to match the
@@ -40,6 +42,7 @@ type GCPServiceAccountKeyEphemeralResource struct {
 type GCPServiceAccountKeyModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Mount         types.String `tfsdk:"mount"`
@@ -126,6 +129,7 @@ func (r *GCPServiceAccountKeyEphemeralResource) Schema(_ context.Context, _ ephe
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -162,7 +166,13 @@ func (r *GCPServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -206,6 +216,12 @@ func (r *GCPServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(vaultSecret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	log.Printf("[DEBUG] Generated GCP service account key from %q", credsPath)
 
 	// Extract private_key_data (base64-encoded from Vault)
@@ -251,5 +267,8 @@ func (r *GCPServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ep
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(vaultSecret.Renewable)
 
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, vaultSecret, data.Namespace.ValueString(), data.Cluster.ValueString())
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
@@ -21,6 +21,8 @@ import (
 
 // Ensure the implementation satisfies the resource.ResourceWithConfigure interface
 var _ ephemeral.EphemeralResource = &GenericEphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithClose = &GenericEphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &GenericEphemeralSecretResource{}
 
 // NewGenericEphemeralSecretResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -30,7 +32,7 @@ var NewGenericEphemeralSecretResource = func() ephemeral.EphemeralResource {
 
 // GenericEphemeralSecretResource implements the methods that define this resource
 type GenericEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // GenericEphemeralSecretModel describes the Terraform resource data model to match the
@@ -38,6 +40,7 @@ type GenericEphemeralSecretResource struct {
 type GenericEphemeralSecretModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Path               types.String `tfsdk:"path"`
@@ -101,6 +104,7 @@ func (r *GenericEphemeralSecretResource) Schema(_ context.Context, _ ephemeral.S
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -117,7 +121,13 @@ func (r *GenericEphemeralSecretResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -151,6 +161,12 @@ func (r *GenericEphemeralSecretResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	// Extract the actual data from the response
 	// For KV v2, the data is nested: response.Data["data"] contains the actual secret
 	// For KV v1 and other engines, the data is directly in response.Data
@@ -204,5 +220,8 @@ func (r *GenericEphemeralSecretResource) Open(ctx context.Context, req ephemeral
 		data.LeaseStartTime = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 	}
 
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, secretResp, data.Namespace.ValueString(), data.Cluster.ValueString())
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
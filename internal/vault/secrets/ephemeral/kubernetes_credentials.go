@@ -19,6 +19,8 @@ import (
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &KubernetesServiceAccountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &KubernetesServiceAccountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &KubernetesServiceAccountTokenEphemeralResource{}
 
 // NewKubernetesServiceAccountTokenEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -28,7 +30,7 @@ var NewKubernetesServiceAccountTokenEphemeralResource = func() ephemeral.Ephemer
 
 // KubernetesServiceAccountTokenEphemeralResource implements the methods that define this resource
 type KubernetesServiceAccountTokenEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	base.EphemeralResourceWithLease
 }
 
 // KubernetesServiceAccountTokenModel describes the Terraform resource data model to match the
@@ -36,6 +38,7 @@ type KubernetesServiceAccountTokenEphemeralResource struct {
 type KubernetesServiceAccountTokenModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Backend                 types.String `tfsdk:"backend"`
@@ -115,6 +118,7 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Schema(_ context.Contex
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -131,7 +135,13 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Open(ctx context.Contex
 		return
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -168,6 +178,12 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Open(ctx context.Contex
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var readResp KubernetesServiceAccountTokenAPIModel
 	err = model.ToAPIModel(secretResp.Data, &readResp)
 	if err != nil {
@@ -182,6 +198,9 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Open(ctx context.Contex
 	data.LeaseDuration = types.Int64Value(int64(secretResp.LeaseDuration))
 	data.LeaseRenewable = types.BoolValue(secretResp.Renewable)
 
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, secretResp, data.Namespace.ValueString(), data.Cluster.ValueString())
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
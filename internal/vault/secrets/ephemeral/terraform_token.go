@@ -37,6 +37,7 @@ type TerraformTokenEphemeralSecretResource struct {
 type TerraformTokenEphemeralSecretModel struct {
 	// common fields to all ephemeral resources
 	base.BaseModelEphemeral
+	base.BaseModelWrapping
 
 	// fields specific to this resource
 	Mount    types.String `tfsdk:"mount"`
@@ -52,6 +53,7 @@ type TerraformTokenEphemeralSecretAPIModel struct {
 type PrivateData struct {
 	LeaseID   string `json:"lease_id"`
 	Namespace string `json:"namespace,omitempty"` // Optional, used for namespaced resources
+	Cluster   string `json:"cluster,omitempty"`   // Optional, used for resources in a provider cluster
 }
 
 // Schema defines this resource's schema which is the data that is available in
@@ -80,6 +82,7 @@ func (r *TerraformTokenEphemeralSecretResource) Schema(_ context.Context, _ ephe
 	}
 
 	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
 }
 
 // Metadata sets the full name for this resource
@@ -101,7 +104,13 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 		data.Mount = types.StringValue("terraform")
 	}
 
-	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -125,6 +134,12 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	var readResp TerraformTokenEphemeralSecretAPIModel
 	err = model.ToAPIModel(secretResp.Data, &readResp)
 	if err != nil {
@@ -135,7 +150,8 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 	data.Token = types.StringValue(readResp.Token)
 	privateData, _ := json.Marshal(PrivateData{
 		LeaseID:   secretResp.LeaseID,
-		Namespace: data.Namespace.ValueString()})
+		Namespace: data.Namespace.ValueString(),
+		Cluster:   data.Cluster.ValueString()})
 	resp.Private.SetKey(ctx, "private_data", privateData)
 
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
@@ -152,12 +168,18 @@ func (e *TerraformTokenEphemeralSecretResource) Close(ctx context.Context, req e
 		return
 	}
 
+	// a wrapped response has no lease, the token is revoked with the lease
+	// of the unwrapped response.
+	if privateBytes == nil {
+		return
+	}
+
 	var privateData PrivateData
 	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
 		resp.Diagnostics.AddError("Unable to unmarshal private data", err.Error())
 		return
 	}
-	c, err := client.GetClient(ctx, e.Meta(), privateData.Namespace)
+	c, err := client.GetClient(ctx, e.Meta(), privateData.Namespace, privateData.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
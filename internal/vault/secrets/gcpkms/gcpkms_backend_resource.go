@@ -132,7 +132,7 @@ func (r *GCPKMSSecretBackendResource) Create(ctx context.Context, req resource.C
 		"is_unknown": data.CredentialsWO.IsUnknown(),
 	})
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -187,7 +187,7 @@ func (r *GCPKMSSecretBackendResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -297,7 +297,7 @@ func (r *GCPKMSSecretBackendResource) Update(ctx context.Context, req resource.U
 		})
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -379,7 +379,7 @@ func (r *GCPKMSSecretBackendResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -405,6 +405,8 @@ func (r *GCPKMSSecretBackendResource) ImportState(ctx context.Context, req resou
 	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // buildBackendConfigFromModel extracts the configuration data from the model
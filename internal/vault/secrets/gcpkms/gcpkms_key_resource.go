@@ -162,7 +162,7 @@ func (r *GCPKMSSecretBackendKeyResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -206,7 +206,7 @@ func (r *GCPKMSSecretBackendKeyResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -321,7 +321,7 @@ func (r *GCPKMSSecretBackendKeyResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -394,7 +394,7 @@ func (r *GCPKMSSecretBackendKeyResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -443,6 +443,8 @@ func (r *GCPKMSSecretBackendKeyResource) ImportState(ctx context.Context, req re
 	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 // buildKeyPath constructs the Vault API path for a GCP KMS key
@@ -50,10 +50,6 @@ func (d *GCPKMSVerifyDataSource) Metadata(_ context.Context, req datasource.Meta
 func (d *GCPKMSVerifyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			consts.FieldNamespace: schema.StringAttribute{
-				MarkdownDescription: "Target namespace.",
-				Optional:            true,
-			},
 			consts.FieldMount: schema.StringAttribute{
 				MarkdownDescription: "Path where the GCP KMS secrets engine is mounted.",
 				Required:            true,
@@ -81,6 +77,8 @@ func (d *GCPKMSVerifyDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		},
 		MarkdownDescription: "Verifies a signature using a GCP KMS key in Vault.",
 	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
 }
 
 func (d *GCPKMSVerifyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -90,7 +88,7 @@ func (d *GCPKMSVerifyDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the list.ListResource interface
+var _ list.ListResourceWithRawV5Schemas = &KVSecretV2ListResource{}
+
+// NewKVSecretV2ListResource returns the implementation for this list resource
+func NewKVSecretV2ListResource() list.ListResource {
+	return &KVSecretV2ListResource{}
+}
+
+// KVSecretV2ListResource lists the secrets of a KV v2 secrets engine
+type KVSecretV2ListResource struct {
+	base.ListResourceWithSDKv2
+}
+
+// KVSecretV2ListModel describes the list resource configuration
+type KVSecretV2ListModel struct {
+	base.BaseModel
+
+	Mount types.String `tfsdk:"mount"`
+	Path  types.String `tfsdk:"path"`
+}
+
+func (r *KVSecretV2ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secret_v2"
+}
+
+func (r *KVSecretV2ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "Only list secrets below this path. Defaults to the root of the mount.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Recursively lists the secrets of a KV-V2 secrets engine.",
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+func (r *KVSecretV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data KVSecretV2ListModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	prefix := strings.Trim(data.Path.ValueString(), "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	names, err := listKVV2Secrets(ctx, cli, mount, prefix, req.Limit)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]base.ListResult, 0, len(names))
+	for _, name := range names {
+		results = append(results, base.ListResult{
+			ID:          fmt.Sprintf("%s/data/%s", mount, name),
+			DisplayName: name,
+		})
+	}
+
+	stream.Results = r.ListResults(ctx, req, data.Namespace.ValueString(), data.Cluster.ValueString(), results)
+}
+
+// listKVV2Secrets recursively lists the names of all secrets below prefix.
+// Listing stops once limit names have been found, if limit is set.
+func listKVV2Secrets(ctx context.Context, cli *api.Client, mount, prefix string, limit int64) ([]string, error) {
+	path := fmt.Sprintf("%s/metadata/%s", mount, prefix)
+
+	tflog.Debug(ctx, "Listing KV-V2 secrets", map[string]any{consts.FieldPath: path})
+	resp, err := cli.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	keys, ok := resp.Data["keys"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for %q", resp.Data["keys"], "keys")
+	}
+
+	var names []string
+	for _, k := range keys {
+		if limit > 0 && int64(len(names)) >= limit {
+			break
+		}
+
+		key := prefix + k.(string)
+		if !strings.HasSuffix(key, "/") {
+			names = append(names, key)
+			continue
+		}
+
+		var remaining int64
+		if limit > 0 {
+			remaining = limit - int64(len(names))
+		}
+		children, err := listKVV2Secrets(ctx, cli, mount, key, remaining)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, children...)
+	}
+
+	return names, nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccKVSecretV2ListResource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_kv_secret_v2" "top" {
+  mount     = vault_mount.kvv2.path
+  name      = "top"
+  data_json = jsonencode({ foo = "bar" })
+}
+
+resource "vault_kv_secret_v2" "nested" {
+  mount     = vault_mount.kvv2.path
+  name      = "app/nested"
+  data_json = jsonencode({ foo = "bar" })
+}
+`, mount),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+provider "vault" {}
+
+list "vault_kv_secret_v2" "all" {
+  provider = vault
+
+  config {
+    mount = "%[1]s"
+  }
+}
+
+list "vault_kv_secret_v2" "app" {
+  provider = vault
+
+  config {
+    mount = "%[1]s"
+    path  = "app"
+  }
+}
+`, mount),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("vault_kv_secret_v2.all", 2),
+					querycheck.ExpectIdentity("vault_kv_secret_v2.all", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(mount + "/data/top"),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+					querycheck.ExpectLength("vault_kv_secret_v2.app", 1),
+					querycheck.ExpectIdentity("vault_kv_secret_v2.app", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(mount + "/data/app/nested"),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
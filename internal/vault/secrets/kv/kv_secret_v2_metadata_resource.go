@@ -0,0 +1,391 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+var metadataIDRe = regexp.MustCompile(`^(.+?)/metadata/(.+)$`)
+
+var (
+	_ resource.ResourceWithConfigure   = &KVSecretV2MetadataResource{}
+	_ resource.ResourceWithImportState = &KVSecretV2MetadataResource{}
+)
+
+// NewKVSecretV2MetadataResource returns the implementation for this resource
+func NewKVSecretV2MetadataResource() resource.Resource {
+	return &KVSecretV2MetadataResource{}
+}
+
+// KVSecretV2MetadataResource manages the metadata of a KV v2 secret,
+// independently of its data.
+type KVSecretV2MetadataResource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretV2MetadataModel describes the Terraform resource data model
+type KVSecretV2MetadataModel struct {
+	base.BaseModel
+
+	Mount              types.String `tfsdk:"mount"`
+	Name               types.String `tfsdk:"name"`
+	Path               types.String `tfsdk:"path"`
+	MaxVersions        types.Int64  `tfsdk:"max_versions"`
+	CASRequired        types.Bool   `tfsdk:"cas_required"`
+	DeleteVersionAfter types.Int64  `tfsdk:"delete_version_after"`
+	CustomMetadata     types.Map    `tfsdk:"custom_metadata"`
+	DeleteAllVersions  types.Bool   `tfsdk:"delete_all_versions"`
+	CurrentVersion     types.Int64  `tfsdk:"current_version"`
+	OldestVersion      types.Int64  `tfsdk:"oldest_version"`
+	CreatedTime        types.String `tfsdk:"created_time"`
+	UpdatedTime        types.String `tfsdk:"updated_time"`
+}
+
+// kvSecretV2MetadataAPIModel describes the Vault API response for the
+// metadata of a KV v2 secret
+type kvSecretV2MetadataAPIModel struct {
+	MaxVersions        int64             `json:"max_versions"`
+	CASRequired        bool              `json:"cas_required"`
+	DeleteVersionAfter string            `json:"delete_version_after"`
+	CustomMetadata     map[string]string `json:"custom_metadata"`
+	CurrentVersion     int64             `json:"current_version"`
+	OldestVersion      int64             `json:"oldest_version"`
+	CreatedTime        string            `json:"created_time"`
+	UpdatedTime        string            `json:"updated_time"`
+}
+
+func (r *KVSecretV2MetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secret_v2_metadata"
+}
+
+func (r *KVSecretV2MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Full name of the secret. For a nested secret, the name is the nested path " +
+					"excluding the mount and metadata prefix.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "Full path of the metadata of the secret.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.FieldMaxVersions: schema.Int64Attribute{
+				MarkdownDescription: "The number of versions to keep for the secret. `0` uses the engine's setting.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			consts.FieldCASRequired: schema.BoolAttribute{
+				MarkdownDescription: "If true, all writes to the secret require the `cas` parameter.",
+				Optional:            true,
+				Computed:            true,
+			},
+			consts.FieldDeleteVersionAfter: schema.Int64Attribute{
+				MarkdownDescription: "Length of time in seconds before a version of the secret is deleted. " +
+					"`0` uses the engine's setting.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			consts.FieldCustomMetadata: schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary string to string valued user-provided metadata meant " +
+					"to describe the secret.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			consts.FieldDeleteAllVersions: schema.BoolAttribute{
+				MarkdownDescription: "If set, destroying the resource permanently deletes the metadata and all " +
+					"versions of the secret. Otherwise the resource is only removed from the Terraform state.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			consts.FieldCurrentVersion: schema.Int64Attribute{
+				MarkdownDescription: "The current version of the secret.",
+				Computed:            true,
+			},
+			consts.FieldOldestVersion: schema.Int64Attribute{
+				MarkdownDescription: "The oldest version of the secret that is kept.",
+				Computed:            true,
+			},
+			consts.FieldCreatedTime: schema.StringAttribute{
+				MarkdownDescription: "The time at which the metadata was created.",
+				Computed:            true,
+			},
+			consts.FieldUpdatedTime: schema.StringAttribute{
+				MarkdownDescription: "The time at which the metadata was last updated.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Manages the metadata of a KV-V2 secret, independently of its data.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+func (r *KVSecretV2MetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan KVSecretV2MetadataModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &plan, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KVSecretV2MetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KVSecretV2MetadataModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Path.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *KVSecretV2MetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KVSecretV2MetadataModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KVSecretV2MetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KVSecretV2MetadataModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadataPath := kvV2MetadataPath(state.Mount.ValueString(), state.Name.ValueString())
+
+	// The data of the secret is usually owned by someone else, so only
+	// delete it when explicitly requested.
+	if !state.DeleteAllVersions.ValueBool() {
+		tflog.Debug(ctx, "Removing KV-V2 metadata from state only", map[string]any{consts.FieldPath: metadataPath})
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting KV-V2 metadata and all versions", map[string]any{consts.FieldPath: metadataPath})
+	if _, err := cli.Logical().DeleteWithContext(ctx, metadataPath); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+func (r *KVSecretV2MetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	matches := metadataIDRe.FindStringSubmatch(req.ID)
+	if len(matches) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be in the format '<mount>/metadata/<name>', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldMount), matches[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldName), matches[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldDeleteAllVersions), false)...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+
+	base.ImportClusterFromEnv(ctx, resp)
+}
+
+// write writes the metadata of the plan to Vault, and refreshes the plan from
+// the result. On update, the custom metadata is always sent so that removed
+// keys are cleared.
+func (r *KVSecretV2MetadataResource) write(ctx context.Context, data *KVSecretV2MetadataModel, update bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	body := map[string]interface{}{}
+	if !data.MaxVersions.IsUnknown() && !data.MaxVersions.IsNull() {
+		body[consts.FieldMaxVersions] = data.MaxVersions.ValueInt64()
+	}
+	if !data.CASRequired.IsUnknown() && !data.CASRequired.IsNull() {
+		body[consts.FieldCASRequired] = data.CASRequired.ValueBool()
+	}
+	if !data.DeleteVersionAfter.IsUnknown() && !data.DeleteVersionAfter.IsNull() {
+		body[consts.FieldDeleteVersionAfter] = data.DeleteVersionAfter.ValueInt64()
+	}
+
+	customMetadata := map[string]string{}
+	if !data.CustomMetadata.IsNull() && !data.CustomMetadata.IsUnknown() {
+		diags.Append(data.CustomMetadata.ElementsAs(ctx, &customMetadata, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if update || len(customMetadata) > 0 {
+		body[consts.FieldCustomMetadata] = customMetadata
+	}
+
+	metadataPath := kvV2MetadataPath(data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Writing KV-V2 metadata", map[string]any{consts.FieldPath: metadataPath})
+	if _, err := cli.Logical().WriteWithContext(ctx, metadataPath, body); err != nil {
+		if update {
+			diags.AddError(errutil.VaultUpdateErr(err))
+		} else {
+			diags.AddError(errutil.VaultCreateErr(err))
+		}
+		return diags
+	}
+
+	diags.Append(r.read(ctx, data)...)
+	if !diags.HasError() && data.Path.IsNull() {
+		diags.AddError(errutil.VaultReadResponseNil())
+	}
+
+	return diags
+}
+
+// read refreshes the model from Vault. The path is set to null if the
+// metadata no longer exists.
+func (r *KVSecretV2MetadataResource) read(ctx context.Context, data *KVSecretV2MetadataModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	metadataPath := kvV2MetadataPath(data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Reading KV-V2 metadata", map[string]any{consts.FieldPath: metadataPath})
+	secret, err := cli.Logical().ReadWithContext(ctx, metadataPath)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		return diags
+	}
+	if secret == nil {
+		tflog.Warn(ctx, "KV-V2 metadata not found, removing from state", map[string]any{consts.FieldPath: metadataPath})
+		data.Path = types.StringNull()
+		return diags
+	}
+
+	var apiModel kvSecretV2MetadataAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+		diags.AddError("Unable to translate Vault response data", err.Error())
+		return diags
+	}
+
+	// delete_version_after is written as seconds but returned as a duration
+	// string, e.g. "3h0m0s".
+	deleteVersionAfter, err := time.ParseDuration(apiModel.DeleteVersionAfter)
+	if err != nil {
+		diags.AddError("Error parsing delete_version_after", err.Error())
+		return diags
+	}
+
+	data.Path = types.StringValue(metadataPath)
+	data.MaxVersions = types.Int64Value(apiModel.MaxVersions)
+	data.CASRequired = types.BoolValue(apiModel.CASRequired)
+	data.DeleteVersionAfter = types.Int64Value(int64(deleteVersionAfter.Seconds()))
+	data.CurrentVersion = types.Int64Value(apiModel.CurrentVersion)
+	data.OldestVersion = types.Int64Value(apiModel.OldestVersion)
+	data.CreatedTime = types.StringValue(apiModel.CreatedTime)
+	data.UpdatedTime = types.StringValue(apiModel.UpdatedTime)
+
+	// keep an unset custom_metadata null rather than an empty map
+	if len(apiModel.CustomMetadata) > 0 || !data.CustomMetadata.IsNull() {
+		if apiModel.CustomMetadata == nil {
+			apiModel.CustomMetadata = map[string]string{}
+		}
+		m, d := types.MapValueFrom(ctx, types.StringType, apiModel.CustomMetadata)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.CustomMetadata = m
+	}
+
+	return diags
+}
+
+func kvV2MetadataPath(mount, name string) string {
+	return fmt.Sprintf("%s/metadata/%s", mount, name)
+}
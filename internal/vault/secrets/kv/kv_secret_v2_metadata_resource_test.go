@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+const testKVSecretV2MetadataResource = "vault_kv_secret_v2_metadata.test"
+
+func TestAccKVSecretV2Metadata(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretV2MetadataConfig(mount, `
+  max_versions         = 5
+  delete_version_after = 3600
+  custom_metadata = {
+    owner = "team-a"
+    tier  = "1"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldName, "app/config"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldPath, mount+"/metadata/app/config"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldMaxVersions, "5"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldCASRequired, "false"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldDeleteVersionAfter, "3600"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, "custom_metadata.owner", "team-a"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldCurrentVersion, "1"),
+					resource.TestCheckResourceAttrSet(testKVSecretV2MetadataResource, consts.FieldCreatedTime),
+				),
+			},
+			{
+				Config: testAccKVSecretV2MetadataConfig(mount, `
+  cas_required = true
+  custom_metadata = {
+    owner = "team-b"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldMaxVersions, "5"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, consts.FieldCASRequired, "true"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(testKVSecretV2MetadataResource, "custom_metadata.owner", "team-b"),
+				),
+			},
+			{
+				ResourceName:                         testKVSecretV2MetadataResource,
+				ImportState:                          true,
+				ImportStateId:                        mount + "/metadata/app/config",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: consts.FieldPath,
+			},
+		},
+	})
+}
+
+func testAccKVSecretV2MetadataConfig(mount, metadata string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_v2" "app" {
+  mount     = vault_mount.kvv2.path
+  name      = "app/config"
+  data_json = jsonencode({ foo = "bar" })
+
+  lifecycle {
+    ignore_changes = [custom_metadata]
+  }
+}
+
+resource "vault_kv_secret_v2_metadata" "test" {
+  mount = vault_mount.kvv2.path
+  name  = vault_kv_secret_v2.app.name
+%s
+}
+`, mount, metadata)
+}
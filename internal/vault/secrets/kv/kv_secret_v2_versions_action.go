@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+var _ action.ActionWithConfigure = &KVSecretV2VersionsAction{}
+
+// NewKVSecretV2DeleteVersionsAction returns the action that soft deletes
+// versions of a KV v2 secret.
+func NewKVSecretV2DeleteVersionsAction() action.Action {
+	return &KVSecretV2VersionsAction{
+		typeName:    "_kv_secret_v2_delete_versions",
+		description: "Soft deletes versions of a KV-V2 secret. Deleted versions can be restored with `vault_kv_secret_v2_undelete_versions`.",
+		operation:   "delete",
+		verb:        "Deleting",
+	}
+}
+
+// NewKVSecretV2UndeleteVersionsAction returns the action that restores soft
+// deleted versions of a KV v2 secret.
+func NewKVSecretV2UndeleteVersionsAction() action.Action {
+	return &KVSecretV2VersionsAction{
+		typeName:    "_kv_secret_v2_undelete_versions",
+		description: "Restores soft deleted versions of a KV-V2 secret.",
+		operation:   "undelete",
+		verb:        "Undeleting",
+	}
+}
+
+// NewKVSecretV2DestroyVersionsAction returns the action that permanently
+// destroys versions of a KV v2 secret.
+func NewKVSecretV2DestroyVersionsAction() action.Action {
+	return &KVSecretV2VersionsAction{
+		typeName:    "_kv_secret_v2_destroy_versions",
+		description: "Permanently destroys the data of versions of a KV-V2 secret. Destroyed versions cannot be restored.",
+		operation:   "destroy",
+		verb:        "Destroying",
+	}
+}
+
+// KVSecretV2VersionsAction implements an action that changes the lifecycle
+// state of selected versions of a KV v2 secret, through the
+// <mount>/<operation>/<name> endpoint.
+type KVSecretV2VersionsAction struct {
+	base.ActionWithConfigure
+
+	typeName    string
+	description string
+	operation   string
+	verb        string
+}
+
+// Metadata defines the action name as it would appear in Terraform configurations.
+func (a *KVSecretV2VersionsAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + a.typeName
+}
+
+// Schema defines this action's schema.
+func (a *KVSecretV2VersionsAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: a.description,
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "Full name of the secret. For a nested secret, the name is the nested path " +
+					"excluding the mount and data prefix.",
+			},
+			consts.FieldVersions: schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The versions of the secret to " + a.operation + ".",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+// Invoke writes the versions to the operation's endpoint.
+func (a *KVSecretV2VersionsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var ns, cluster, mount, name types.String
+	var versions []int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldCluster), &cluster)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldName), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldVersions), &versions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), ns.ValueString(), cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	versionsPath := fmt.Sprintf("%s/%s/%s", mount.ValueString(), a.operation, name.ValueString())
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s versions %v of %q", a.verb, versions, name.ValueString()),
+	})
+
+	tflog.Debug(ctx, fmt.Sprintf("%s KV-V2 secret versions", a.verb), map[string]any{
+		consts.FieldPath:     versionsPath,
+		consts.FieldVersions: versions,
+	})
+	if _, err := c.Logical().WriteWithContext(ctx, versionsPath, map[string]interface{}{
+		consts.FieldVersions: versions,
+	}); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultInvokeErr(err))
+		return
+	}
+}
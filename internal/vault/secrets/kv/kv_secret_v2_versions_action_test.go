@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccKVSecretV2VersionsActions(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+	name := "app/config"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if err := client.Sys().Mount(mount, &api.MountInput{
+						Type:    "kv",
+						Options: map[string]string{"version": "2"},
+					}); err != nil {
+						t.Fatal(err)
+					}
+					t.Cleanup(func() {
+						_ = client.Sys().Unmount(mount)
+					})
+
+					for i := 1; i <= 3; i++ {
+						if _, err := client.KVv2(mount).Put(context.Background(), name, map[string]interface{}{
+							"version": i,
+						}); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: fmt.Sprintf(`
+action "vault_kv_secret_v2_delete_versions" "delete" {
+  config {
+    mount    = "%[1]s"
+    name     = "%[2]s"
+    versions = [1, 2]
+  }
+}
+
+action "vault_kv_secret_v2_undelete_versions" "undelete" {
+  config {
+    mount    = "%[1]s"
+    name     = "%[2]s"
+    versions = [1]
+  }
+}
+
+action "vault_kv_secret_v2_destroy_versions" "destroy" {
+  config {
+    mount    = "%[1]s"
+    name     = "%[2]s"
+    versions = [3]
+  }
+}
+
+resource "terraform_data" "versions" {
+  input = "%[2]s"
+
+  lifecycle {
+    action_trigger {
+      events = [after_create]
+      actions = [
+        action.vault_kv_secret_v2_delete_versions.delete,
+        action.vault_kv_secret_v2_undelete_versions.undelete,
+        action.vault_kv_secret_v2_destroy_versions.destroy,
+      ]
+    }
+  }
+}
+`, mount, name),
+				Check: testAccCheckKVSecretV2Versions(mount, name),
+			},
+		},
+	})
+}
+
+func testAccCheckKVSecretV2Versions(mount, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		versions, err := client.KVv2(mount).GetVersionsAsList(context.Background(), name)
+		if err != nil {
+			return err
+		}
+		if len(versions) != 3 {
+			return fmt.Errorf("expected 3 versions, got %d", len(versions))
+		}
+
+		for _, v := range versions {
+			deleted := !v.DeletionTime.IsZero()
+			var wantDeleted, wantDestroyed bool
+			switch v.Version {
+			case 2:
+				wantDeleted = true
+			case 3:
+				wantDestroyed = true
+			}
+
+			if deleted != wantDeleted {
+				return fmt.Errorf("expected version %d deleted=%t, got %t", v.Version, wantDeleted, deleted)
+			}
+			if v.Destroyed != wantDestroyed {
+				return fmt.Errorf("expected version %d destroyed=%t, got %t", v.Version, wantDestroyed, v.Destroyed)
+			}
+		}
+
+		return nil
+	}
+}
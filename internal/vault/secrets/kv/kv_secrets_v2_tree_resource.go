@@ -0,0 +1,602 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+var treeRelativePathRe = regexp.MustCompile(`^[^/]+(/[^/]+)*$`)
+
+var (
+	_ resource.ResourceWithConfigure      = &KVSecretsV2TreeResource{}
+	_ resource.ResourceWithValidateConfig = &KVSecretsV2TreeResource{}
+)
+
+// NewKVSecretsV2TreeResource returns the implementation for this resource
+func NewKVSecretsV2TreeResource() resource.Resource {
+	return &KVSecretsV2TreeResource{}
+}
+
+// KVSecretsV2TreeResource manages all the secrets below a prefix of a KV v2
+// secrets engine as a single resource. Only the secrets whose data differs
+// from Vault are written, using check-and-set.
+type KVSecretsV2TreeResource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretsV2TreeModel describes the Terraform resource data model
+type KVSecretsV2TreeModel struct {
+	base.BaseModel
+
+	Mount             types.String `tfsdk:"mount"`
+	Prefix            types.String `tfsdk:"prefix"`
+	Secrets           types.Map    `tfsdk:"secrets"`
+	SecretsWO         types.Map    `tfsdk:"secrets_wo"`
+	SecretsWOVersion  types.Int64  `tfsdk:"secrets_wo_version"`
+	Prune             types.Bool   `tfsdk:"prune"`
+	DeleteAllVersions types.Bool   `tfsdk:"delete_all_versions"`
+	Versions          types.Map    `tfsdk:"versions"`
+}
+
+func (r *KVSecretsV2TreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secrets_v2_tree"
+}
+
+func (r *KVSecretsV2TreeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	relativePathValidator := stringvalidator.RegexMatches(treeRelativePathRe,
+		"must be a relative path without leading, trailing or repeated slashes")
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldPrefix: schema.StringAttribute{
+				MarkdownDescription: "Path below the mount under which the secrets are managed. " +
+					"Defaults to the root of the mount.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(stringvalidator.OneOf(""), relativePathValidator),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldSecrets: schema.MapAttribute{
+				MarkdownDescription: "A map of the path of each secret, relative to `prefix`, to the " +
+					"JSON-encoded data of the secret.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.ExactlyOneOf(path.MatchRoot(consts.FieldSecretsWO)),
+					mapvalidator.KeysAre(relativePathValidator),
+				},
+			},
+			consts.FieldSecretsWO: schema.MapAttribute{
+				MarkdownDescription: "Write-only map of the path of each secret, relative to `prefix`, to the " +
+					"JSON-encoded data of the secret. The data is not stored in the Terraform state.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.Map{
+					mapvalidator.ExactlyOneOf(path.MatchRoot(consts.FieldSecrets)),
+					mapvalidator.KeysAre(relativePathValidator),
+				},
+			},
+			consts.FieldSecretsWOVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version counter for the write-only `secrets_wo` field. " +
+					"Since write-only values are not stored in state, Terraform cannot detect when the secrets change. " +
+					"Increment this value whenever you update `secrets_wo` to ensure the changes are sent to Vault.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot(consts.FieldSecretsWO)),
+				},
+			},
+			consts.FieldPrune: schema.BoolAttribute{
+				MarkdownDescription: "If set, secrets below `prefix` that are not managed by this resource " +
+					"are deleted.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			consts.FieldDeleteAllVersions: schema.BoolAttribute{
+				MarkdownDescription: "If set, deleting a secret permanently deletes its metadata and all of " +
+					"its versions. Otherwise only the latest version is soft deleted.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			consts.FieldVersions: schema.MapAttribute{
+				MarkdownDescription: "A map of the path of each managed secret, relative to `prefix`, to its " +
+					"current version.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+		MarkdownDescription: "Manages all the secrets below a prefix of a KV-V2 secrets engine. " +
+			"Only the secrets whose data changed are written.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+// ValidateConfig ensures that the data of each secret is a JSON object.
+func (r *KVSecretsV2TreeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, field := range []string{consts.FieldSecrets, consts.FieldSecretsWO} {
+		var secrets types.Map
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(field), &secrets)...)
+		if resp.Diagnostics.HasError() || secrets.IsNull() || secrets.IsUnknown() {
+			continue
+		}
+
+		for k, v := range secrets.Elements() {
+			s, ok := v.(types.String)
+			if !ok || s.IsNull() || s.IsUnknown() {
+				continue
+			}
+			if _, err := decodeTreeSecret(s.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(field).AtMapKey(k),
+					"Invalid secret data",
+					fmt.Sprintf("The data of %q must be a JSON object: %s", k, err),
+				)
+			}
+		}
+	}
+}
+
+func (r *KVSecretsV2TreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan KVSecretsV2TreeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, req.Config, &plan, nil, errutil.VaultCreateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KVSecretsV2TreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KVSecretsV2TreeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *KVSecretsV2TreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state KVSecretsV2TreeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := treeVersions(ctx, state.Versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, req.Config, &plan, managed, errutil.VaultUpdateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KVSecretsV2TreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KVSecretsV2TreeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := treeVersions(ctx, state.Versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	prefix := treePrefix(state.Prefix.ValueString())
+	for _, rel := range sortedKeys(managed) {
+		if err := deleteTreeSecret(ctx, cli, state.Mount.ValueString(), prefix+rel, state.DeleteAllVersions.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+			return
+		}
+	}
+}
+
+// write brings the secrets below the prefix in line with the plan. Secrets
+// whose data is unchanged in Vault are not written, and the secrets that were
+// managed before but are no longer configured are deleted, as well as any
+// other secret below the prefix if pruning is enabled. The versions of the
+// plan are set from the result.
+func (r *KVSecretsV2TreeResource) write(ctx context.Context, config tfsdk.Config, data *KVSecretsV2TreeModel, managed map[string]int64, errFunc func(error) (string, string)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	secrets := data.Secrets
+	if secrets.IsNull() {
+		diags.Append(config.GetAttribute(ctx, path.Root(consts.FieldSecretsWO), &secrets)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	desired := map[string]string{}
+	diags.Append(secrets.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	mount := data.Mount.ValueString()
+	prefix := treePrefix(data.Prefix.ValueString())
+	versions := make(map[string]int64, len(desired))
+
+	for _, rel := range sortedKeys(desired) {
+		want, err := decodeTreeSecret(desired[rel])
+		if err != nil {
+			diags.AddError(errFunc(fmt.Errorf("invalid data for %q: %w", rel, err)))
+			return diags
+		}
+
+		current, version, err := readTreeSecret(ctx, cli, mount, prefix+rel)
+		if err != nil {
+			diags.AddError(errFunc(err))
+			return diags
+		}
+
+		if current != nil && treeSecretsEqual(current, want) {
+			tflog.Debug(ctx, "KV-V2 secret is unchanged, skipping write", map[string]any{consts.FieldName: prefix + rel})
+			versions[rel] = version
+			continue
+		}
+
+		dataPath := kvV2DataPath(mount, prefix+rel)
+		tflog.Debug(ctx, "Writing KV-V2 secret", map[string]any{consts.FieldPath: dataPath, consts.FieldCAS: version})
+		resp, err := cli.Logical().WriteWithContext(ctx, dataPath, map[string]interface{}{
+			consts.FieldData: want,
+			consts.FieldOptions: map[string]interface{}{
+				consts.FieldCAS: version,
+			},
+		})
+		if err != nil {
+			diags.AddError(errFunc(fmt.Errorf("error writing %q: %w", dataPath, err)))
+			return diags
+		}
+		if resp == nil {
+			diags.AddError(errutil.VaultReadResponseNil())
+			return diags
+		}
+
+		v, err := jsonInt64(resp.Data[consts.FieldVersion])
+		if err != nil {
+			diags.AddError(errFunc(err))
+			return diags
+		}
+		versions[rel] = v
+	}
+
+	var stale []string
+	for rel := range managed {
+		if _, ok := desired[rel]; !ok {
+			stale = append(stale, rel)
+		}
+	}
+	if data.Prune.ValueBool() {
+		names, err := listKVV2Secrets(ctx, cli, mount, prefix, 0)
+		if err != nil {
+			diags.AddError(errFunc(err))
+			return diags
+		}
+		for _, name := range names {
+			rel := strings.TrimPrefix(name, prefix)
+			_, isDesired := desired[rel]
+			_, isManaged := managed[rel]
+			if !isDesired && !isManaged {
+				stale = append(stale, rel)
+			}
+		}
+	}
+
+	sort.Strings(stale)
+	for _, rel := range stale {
+		if err := deleteTreeSecret(ctx, cli, mount, prefix+rel, data.DeleteAllVersions.ValueBool()); err != nil {
+			diags.AddError(errFunc(err))
+			return diags
+		}
+	}
+
+	m, d := types.MapValueFrom(ctx, types.Int64Type, versions)
+	diags.Append(d...)
+	data.Versions = m
+
+	return diags
+}
+
+// read refreshes the managed secrets from Vault. Secrets that were deleted
+// outside of Terraform are dropped, so that they are written again. When
+// the data is not write-only, drift in the data of the secrets is reported,
+// and unmanaged secrets are added if pruning is enabled, so that the plan
+// shows their removal.
+func (r *KVSecretsV2TreeResource) read(ctx context.Context, data *KVSecretsV2TreeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	managed, d := treeVersions(ctx, data.Versions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	prior := map[string]string{}
+	if !data.Secrets.IsNull() {
+		diags.Append(data.Secrets.ElementsAs(ctx, &prior, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	mount := data.Mount.ValueString()
+	prefix := treePrefix(data.Prefix.ValueString())
+	writeOnly := data.Secrets.IsNull()
+
+	names := sortedKeys(managed)
+	if !writeOnly && data.Prune.ValueBool() {
+		listed, err := listKVV2Secrets(ctx, cli, mount, prefix, 0)
+		if err != nil {
+			diags.AddError(errutil.VaultReadErr(err))
+			return diags
+		}
+		for _, name := range listed {
+			if rel := strings.TrimPrefix(name, prefix); !containsKey(managed, rel) {
+				names = append(names, rel)
+			}
+		}
+	}
+
+	versions := map[string]int64{}
+	secrets := map[string]string{}
+	for _, rel := range names {
+		current, version, err := readTreeSecret(ctx, cli, mount, prefix+rel)
+		if err != nil {
+			diags.AddError(errutil.VaultReadErr(err))
+			return diags
+		}
+		if current == nil {
+			tflog.Warn(ctx, "KV-V2 secret not found", map[string]any{consts.FieldName: prefix + rel})
+			continue
+		}
+
+		if containsKey(managed, rel) {
+			versions[rel] = version
+		}
+		if writeOnly {
+			continue
+		}
+
+		// keep the configured encoding of unchanged data
+		if s, ok := prior[rel]; ok {
+			if want, err := decodeTreeSecret(s); err == nil && treeSecretsEqual(current, want) {
+				secrets[rel] = s
+				continue
+			}
+		}
+
+		b, err := json.Marshal(current)
+		if err != nil {
+			diags.AddError("Error encoding secret data", err.Error())
+			return diags
+		}
+		secrets[rel] = string(b)
+	}
+
+	m, d := types.MapValueFrom(ctx, types.Int64Type, versions)
+	diags.Append(d...)
+	data.Versions = m
+
+	if !writeOnly {
+		m, d := types.MapValueFrom(ctx, types.StringType, secrets)
+		diags.Append(d...)
+		data.Secrets = m
+	}
+
+	return diags
+}
+
+// readTreeSecret returns the data and the current version of a secret. The
+// data is nil if the secret does not exist or its current version is deleted
+// or destroyed, in which case the version is the one to use for
+// check-and-set.
+func readTreeSecret(ctx context.Context, cli *api.Client, mount, name string) (map[string]interface{}, int64, error) {
+	dataPath := kvV2DataPath(mount, name)
+
+	tflog.Debug(ctx, "Reading KV-V2 secret", map[string]any{consts.FieldPath: dataPath})
+	resp, err := cli.Logical().ReadWithContext(ctx, dataPath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error reading %q: %w", dataPath, err)
+	}
+	if resp == nil {
+		return nil, 0, nil
+	}
+
+	var version int64
+	if metadata, ok := resp.Data[consts.FieldMetadata].(map[string]interface{}); ok {
+		if version, err = jsonInt64(metadata[consts.FieldVersion]); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	data, _ := resp.Data[consts.FieldData].(map[string]interface{})
+
+	return data, version, nil
+}
+
+func deleteTreeSecret(ctx context.Context, cli *api.Client, mount, name string, allVersions bool) error {
+	p := kvV2DataPath(mount, name)
+	if allVersions {
+		p = kvV2MetadataPath(mount, name)
+	}
+
+	tflog.Debug(ctx, "Deleting KV-V2 secret", map[string]any{consts.FieldPath: p})
+	if _, err := cli.Logical().DeleteWithContext(ctx, p); err != nil {
+		return fmt.Errorf("error deleting %q: %w", p, err)
+	}
+
+	return nil
+}
+
+// decodeTreeSecret decodes the JSON-encoded data of a secret, keeping numbers
+// as json.Number like the Vault API client does.
+func decodeTreeSecret(s string) (map[string]interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var data map[string]interface{}
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("data must not be null")
+	}
+
+	return data, nil
+}
+
+// treeSecretsEqual compares the canonical JSON encoding of two secrets.
+func treeSecretsEqual(a, b map[string]interface{}) bool {
+	ab, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(ab, bb)
+}
+
+func treeVersions(ctx context.Context, m types.Map) (map[string]int64, diag.Diagnostics) {
+	versions := map[string]int64{}
+	if m.IsNull() || m.IsUnknown() {
+		return versions, nil
+	}
+
+	diags := m.ElementsAs(ctx, &versions, false)
+
+	return versions, diags
+}
+
+// treePrefix returns the prefix with a trailing slash, unless it is the root
+// of the mount.
+func treePrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(prefix, "/") + "/"
+}
+
+func jsonInt64(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	case nil:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T for version", v)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func containsKey[V any](m map[string]V, k string) bool {
+	_, ok := m[k]
+	return ok
+}
+
+func kvV2DataPath(mount, name string) string {
+	return fmt.Sprintf("%s/data/%s", mount, name)
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+const testKVSecretsV2TreeResource = "vault_kv_secrets_v2_tree.test"
+
+func TestAccKVSecretsV2Tree(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretsV2TreeConfig(mount, `
+  secrets = {
+    "db"  = jsonencode({ user = "app", port = 5432 })
+    "api" = jsonencode({ token = "abc" })
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, consts.FieldPrefix, "app"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "secrets.%", "2"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.%", "2"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.db", "1"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.api", "1"),
+				),
+			},
+			{
+				// only the changed and new secrets are written
+				Config: testAccKVSecretsV2TreeConfig(mount, `
+  secrets = {
+    "db"          = jsonencode({ user = "app", port = 5433 })
+    "api"         = jsonencode({ token = "abc" })
+    "cache/redis" = jsonencode({ host = "localhost" })
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.%", "3"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.db", "2"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.api", "1"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.cache/redis", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if _, err := client.KVv2(mount).Put(context.Background(), "app/stray", map[string]interface{}{
+						"foo": "bar",
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKVSecretsV2TreeConfig(mount, `
+  prune = true
+  secrets = {
+    "db"          = jsonencode({ user = "app", port = 5433 })
+    "cache/redis" = jsonencode({ host = "localhost" })
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, consts.FieldPrune, "true"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.%", "2"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.db", "2"),
+					testAccCheckKVSecretsV2TreeDeleted(mount, "app/api"),
+					testAccCheckKVSecretsV2TreeDeleted(mount, "app/stray"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKVSecretsV2Tree_writeOnly(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretsV2TreeConfig(mount, `
+  secrets_wo_version = 1
+  secrets_wo = {
+    "db"  = jsonencode({ password = "one" })
+    "api" = jsonencode({ token = "abc" })
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(testKVSecretsV2TreeResource, consts.FieldSecrets),
+					resource.TestCheckNoResourceAttr(testKVSecretsV2TreeResource, consts.FieldSecretsWO),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, consts.FieldSecretsWOVersion, "1"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.db", "1"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.api", "1"),
+				),
+			},
+			{
+				Config: testAccKVSecretsV2TreeConfig(mount, `
+  secrets_wo_version = 2
+  secrets_wo = {
+    "db"  = jsonencode({ password = "two" })
+    "api" = jsonencode({ token = "abc" })
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, consts.FieldSecretsWOVersion, "2"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.db", "2"),
+					resource.TestCheckResourceAttr(testKVSecretsV2TreeResource, "versions.api", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKVSecretsV2TreeDeleted(mount, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		secret, err := client.Logical().Read(fmt.Sprintf("%s/data/%s", mount, name))
+		if err != nil {
+			return err
+		}
+		if secret != nil && secret.Data["data"] != nil {
+			return fmt.Errorf("expected secret %q to be deleted, got %v", name, secret.Data["data"])
+		}
+
+		return nil
+	}
+}
+
+func testAccKVSecretsV2TreeConfig(mount, secrets string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secrets_v2_tree" "test" {
+  mount  = vault_mount.kvv2.path
+  prefix = "app"
+%s
+}
+`, mount, secrets)
+}
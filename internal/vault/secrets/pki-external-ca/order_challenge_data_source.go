@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/sdk/helper/strutil"
 
+	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
@@ -118,15 +119,12 @@ func (d *PKIExternalCAOrderChallengeDataSource) Schema(_ context.Context, _ data
 		MarkdownDescription: "Retrieves ACME challenge details for a specific identifier in an order.",
 	}
 
-	// Manually add namespace field for data sources
-	resp.Schema.Attributes[consts.FieldNamespace] = schema.StringAttribute{
-		Optional:            true,
-		MarkdownDescription: "Target namespace. (requires Enterprise)",
-	}
 	resp.Schema.Attributes[consts.FieldID] = schema.StringAttribute{
 		Computed:            true,
 		MarkdownDescription: "Unique identifier for this data source.",
 	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
 }
 
 func isOrderStatusTerminal(status string) bool {
@@ -158,8 +156,13 @@ func orderStatus(ctx context.Context, cli *api.Client, mount, roleName, orderID
 }
 
 func pollOrderStatus(ctx context.Context, cli *api.Client, mount, roleName, orderID string, attempts int, interval time.Duration, desired []string) error {
+	// the status changes while it is polled, so it must not be cached
+	cli, err := helper.DisableReadCache(cli)
+	if err != nil {
+		return err
+	}
+
 	var status string
-	var err error
 	for range attempts {
 		status, err = orderStatus(ctx, cli, mount, roleName, orderID)
 		if err != nil {
@@ -195,7 +198,7 @@ func (d *PKIExternalCAOrderChallengeDataSource) Read(ctx context.Context, req da
 		return
 	}
 
-	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
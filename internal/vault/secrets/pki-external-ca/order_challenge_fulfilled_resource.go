@@ -117,7 +117,7 @@ func (r *PKIExternalCAOrderChallengeFulfilledResource) Create(ctx context.Contex
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -198,4 +198,6 @@ func (r *PKIExternalCAOrderChallengeFulfilledResource) ImportState(ctx context.C
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("order_id"), orderID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("challenge_type"), challengeType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identifier)...)
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/certutil"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+const defaultIssuerRef = "default"
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &PKISecretBackendCRLDataSource{}
+
+// NewPKISecretBackendCRLDataSource returns the implementation for this data source
+func NewPKISecretBackendCRLDataSource() datasource.DataSource {
+	return &PKISecretBackendCRLDataSource{}
+}
+
+// PKISecretBackendCRLDataSource implements the methods that define this data source
+type PKISecretBackendCRLDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// PKISecretBackendCRLModel describes the Terraform data source data model
+type PKISecretBackendCRLModel struct {
+	base.BaseModel
+
+	Mount                types.String   `tfsdk:"mount"`
+	IssuerRef            types.String   `tfsdk:"issuer_ref"`
+	Delta                types.Bool     `tfsdk:"delta"`
+	Unified              types.Bool     `tfsdk:"unified"`
+	CRL                  types.String   `tfsdk:"crl"`
+	CRLNumber            types.Int64    `tfsdk:"crl_number"`
+	ThisUpdate           types.String   `tfsdk:"this_update"`
+	NextUpdate           types.String   `tfsdk:"next_update"`
+	RevokedSerialNumbers []types.String `tfsdk:"revoked_serial_numbers"`
+	RevokedCertificates  types.List     `tfsdk:"revoked_certificates"`
+}
+
+// RevokedCertificateModel describes an entry of a CRL
+type RevokedCertificateModel struct {
+	SerialNumber   types.String `tfsdk:"serial_number"`
+	RevocationTime types.String `tfsdk:"revocation_time"`
+}
+
+var revokedCertificateType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	consts.FieldSerialNumber:   types.StringType,
+	consts.FieldRevocationTime: types.StringType,
+}}
+
+func (d *PKISecretBackendCRLDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_crl"
+}
+
+func (d *PKISecretBackendCRLDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+			consts.FieldIssuerRef: schema.StringAttribute{
+				MarkdownDescription: "Reference to the issuer of the CRL, either its name or ID. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			consts.FieldDelta: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to read the delta CRL instead of the complete CRL.",
+				Optional:            true,
+			},
+			consts.FieldUnified: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to read the unified CRL, that contains the revocations of all the clusters " +
+					"of a replicated PKI secrets engine.",
+				Optional: true,
+			},
+			consts.FieldCRL: schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded CRL.",
+				Computed:            true,
+			},
+			consts.FieldCRLNumber: schema.Int64Attribute{
+				MarkdownDescription: "The CRL number of the CRL.",
+				Computed:            true,
+			},
+			consts.FieldThisUpdate: schema.StringAttribute{
+				MarkdownDescription: "The time at which the CRL was issued, in RFC3339 format.",
+				Computed:            true,
+			},
+			consts.FieldNextUpdate: schema.StringAttribute{
+				MarkdownDescription: "The time by which the next CRL will be issued, in RFC3339 format.",
+				Computed:            true,
+			},
+			consts.FieldRevokedSerialNumbers: schema.ListAttribute{
+				MarkdownDescription: "The serial numbers of the revoked certificates, in the hex format used by Vault.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			consts.FieldRevokedCertificates: schema.ListAttribute{
+				MarkdownDescription: "The revoked certificates of the CRL, with their `serial_number` and `revocation_time` " +
+					"in RFC3339 format.",
+				ElementType: revokedCertificateType,
+				Computed:    true,
+			},
+		},
+		MarkdownDescription: "Reads and parses a CRL of a PKI secrets engine issuer.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *PKISecretBackendCRLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PKISecretBackendCRLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if data.IssuerRef.IsNull() || data.IssuerRef.IsUnknown() {
+		data.IssuerRef = types.StringValue(defaultIssuerRef)
+	}
+
+	crlType := "crl"
+	if data.Unified.ValueBool() {
+		crlType = "unified-crl"
+	}
+	path := fmt.Sprintf("%s/issuer/%s/%s", data.Mount.ValueString(), data.IssuerRef.ValueString(), crlType)
+	if data.Delta.ValueBool() {
+		path += "/delta"
+	}
+
+	tflog.Debug(ctx, "Reading PKI CRL", map[string]any{consts.FieldPath: path})
+	secret, err := cli.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	crlPEM, ok := secret.Data[consts.FieldCRL].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected API response",
+			fmt.Sprintf("expected string for %q, got %T", consts.FieldCRL, secret.Data[consts.FieldCRL]),
+		)
+		return
+	}
+
+	issuer, err := readIssuerCertificate(ctx, cli, data.Mount.ValueString(), data.IssuerRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	crl, err := parseCRL(crlPEM, issuer)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing CRL", err.Error())
+		return
+	}
+
+	data.CRL = types.StringValue(crlPEM)
+	data.ThisUpdate = types.StringValue(crl.ThisUpdate.UTC().Format(time.RFC3339))
+	data.NextUpdate = types.StringValue(crl.NextUpdate.UTC().Format(time.RFC3339))
+	data.CRLNumber = types.Int64Null()
+	if crl.Number != nil && crl.Number.IsInt64() {
+		data.CRLNumber = types.Int64Value(crl.Number.Int64())
+	}
+
+	data.RevokedSerialNumbers = make([]types.String, 0, len(crl.RevokedCertificateEntries))
+	revoked := make([]RevokedCertificateModel, 0, len(crl.RevokedCertificateEntries))
+	for _, e := range crl.RevokedCertificateEntries {
+		serial := types.StringValue(certutil.GetHexFormatted(e.SerialNumber.Bytes(), ":"))
+		data.RevokedSerialNumbers = append(data.RevokedSerialNumbers, serial)
+		revoked = append(revoked, RevokedCertificateModel{
+			SerialNumber:   serial,
+			RevocationTime: types.StringValue(e.RevocationTime.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.RevokedCertificates, diags = types.ListValueFrom(ctx, revokedCertificateType, revoked)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readIssuerCertificate returns the certificate of the issuer issuerRef.
+func readIssuerCertificate(ctx context.Context, cli *api.Client, mount, issuerRef string) (*x509.Certificate, error) {
+	path := fmt.Sprintf("%s/issuer/%s/json", mount, issuerRef)
+	secret, err := cli.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("issuer %q not found in %q", issuerRef, mount)
+	}
+
+	certPEM, ok := secret.Data[consts.FieldCertificate].(string)
+	if !ok {
+		return nil, fmt.Errorf("expected string for %q, got %T", consts.FieldCertificate, secret.Data[consts.FieldCertificate])
+	}
+
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM certificate for issuer %q", issuerRef)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// parseCRL parses the PEM encoded CRL, and verifies that it was signed by
+// issuer.
+func parseCRL(crlPEM string, issuer *x509.Certificate) (*x509.RevocationList, error) {
+	block, _ := pem.Decode([]byte(crlPEM))
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM encoded CRL")
+	}
+
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := crl.CheckSignatureFrom(issuer); err != nil {
+		return nil, fmt.Errorf("CRL signature verification failed: %w", err)
+	}
+
+	return crl, nil
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPKISecretBackendCRLDataSource(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	crlDataSource := "data.vault_pki_secret_backend_crl.test"
+
+	var serial string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendCRLDataSourceConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vault_pki_secret_backend_cert.revoked", consts.FieldSerialNumber, func(v string) error {
+						serial = v
+						return nil
+					}),
+					resource.TestCheckResourceAttr(crlDataSource, consts.FieldIssuerRef, "default"),
+					resource.TestCheckResourceAttrSet(crlDataSource, consts.FieldCRL),
+					resource.TestCheckResourceAttrSet(crlDataSource, consts.FieldCRLNumber),
+					resource.TestCheckResourceAttrSet(crlDataSource, consts.FieldThisUpdate),
+					resource.TestCheckResourceAttrSet(crlDataSource, consts.FieldNextUpdate),
+					resource.TestCheckResourceAttr(crlDataSource, consts.FieldRevokedCertificates+".#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if _, err := client.Logical().Write(fmt.Sprintf("%s/revoke", backend), map[string]interface{}{
+						consts.FieldSerialNumber: serial,
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccPKISecretBackendCRLDataSourceConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(crlDataSource, consts.FieldRevokedCertificates+".#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(crlDataSource, consts.FieldRevokedSerialNumbers+".*",
+						"vault_pki_secret_backend_cert.revoked", consts.FieldSerialNumber),
+					resource.TestCheckResourceAttrPair(crlDataSource, consts.FieldRevokedCertificates+".0."+consts.FieldSerialNumber,
+						"vault_pki_secret_backend_cert.revoked", consts.FieldSerialNumber),
+					resource.TestCheckResourceAttrSet(crlDataSource, consts.FieldRevokedCertificates+".0."+consts.FieldRevocationTime),
+				),
+			},
+		},
+	})
+}
+
+func testAccPKISecretBackendCRLDataSourceConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "root" {
+  backend     = vault_mount.pki.path
+  type        = "internal"
+  common_name = "example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.root.backend
+  name             = "test"
+  allowed_domains  = ["example.com"]
+  allow_subdomains = true
+}
+
+resource "vault_pki_secret_backend_cert" "good" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "good.example.com"
+}
+
+resource "vault_pki_secret_backend_cert" "revoked" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "revoked.example.com"
+}
+
+data "vault_pki_secret_backend_crl" "test" {
+  mount = vault_mount.pki.path
+
+  depends_on = [vault_pki_secret_backend_cert.good, vault_pki_secret_backend_cert.revoked]
+}
+`, backend)
+}
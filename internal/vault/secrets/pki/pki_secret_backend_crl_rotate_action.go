@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+// Ensure the implementation satisfies the action.ActionWithConfigure interface
+var _ action.ActionWithConfigure = &PKISecretBackendCRLRotateAction{}
+
+// NewPKISecretBackendCRLRotateAction returns the implementation for this action
+func NewPKISecretBackendCRLRotateAction() action.Action {
+	return &PKISecretBackendCRLRotateAction{}
+}
+
+// PKISecretBackendCRLRotateAction forces the rebuild of the CRLs of a PKI
+// secrets engine.
+type PKISecretBackendCRLRotateAction struct {
+	base.ActionWithConfigure
+}
+
+func (a *PKISecretBackendCRLRotateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_crl_rotate"
+}
+
+func (a *PKISecretBackendCRLRotateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forces the rebuild of the CRLs of a PKI secrets engine.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+			consts.FieldDelta: schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Set to true to rebuild only the delta CRLs. " +
+					"Requires delta CRLs to be enabled on the PKI secrets engine.",
+			},
+		},
+	}
+
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *PKISecretBackendCRLRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var ns, cluster, mount types.String
+	var delta types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldCluster), &cluster)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldDelta), &delta)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), ns.ValueString(), cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	// the rotation is triggered by a read, which must always reach Vault.
+	c, err = helper.DisableReadCache(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	rotatePath := fmt.Sprintf("%s/crl/rotate", mount.ValueString())
+	if delta.ValueBool() {
+		rotatePath = fmt.Sprintf("%s/crl/rotate-delta", mount.ValueString())
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rebuilding CRLs at %q", rotatePath),
+	})
+
+	tflog.Debug(ctx, "Rebuilding PKI CRLs", map[string]any{
+		consts.FieldPath: rotatePath,
+	})
+	secret, err := c.Logical().ReadWithContext(ctx, rotatePath)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultInvokeErr(err))
+		return
+	}
+
+	if secret != nil {
+		for _, w := range secret.Warnings {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: w,
+			})
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPKISecretBackendCRLRotateAction(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "root" {
+  backend     = vault_mount.pki.path
+  type        = "internal"
+  common_name = "example.com"
+  ttl         = "86400"
+}
+
+action "vault_pki_secret_backend_crl_rotate" "rotate" {
+  config {
+    mount = vault_mount.pki.path
+  }
+}
+
+resource "terraform_data" "rotate" {
+  input = vault_pki_secret_backend_root_cert.root.serial_number
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_pki_secret_backend_crl_rotate.rotate]
+    }
+  }
+}
+`, backend),
+			},
+		},
+	})
+}
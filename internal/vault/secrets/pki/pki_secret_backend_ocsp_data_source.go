@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+const (
+	ocspStatusGood    = "good"
+	ocspStatusRevoked = "revoked"
+	ocspStatusUnknown = "unknown"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &PKISecretBackendOCSPDataSource{}
+
+// NewPKISecretBackendOCSPDataSource returns the implementation for this data source
+func NewPKISecretBackendOCSPDataSource() datasource.DataSource {
+	return &PKISecretBackendOCSPDataSource{}
+}
+
+// PKISecretBackendOCSPDataSource implements the methods that define this data source
+type PKISecretBackendOCSPDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// PKISecretBackendOCSPModel describes the Terraform data source data model
+type PKISecretBackendOCSPModel struct {
+	base.BaseModel
+
+	Mount            types.String `tfsdk:"mount"`
+	IssuerRef        types.String `tfsdk:"issuer_ref"`
+	SerialNumber     types.String `tfsdk:"serial_number"`
+	Status           types.String `tfsdk:"status"`
+	RevokedAt        types.String `tfsdk:"revoked_at"`
+	RevocationReason types.Int64  `tfsdk:"revocation_reason"`
+	ProducedAt       types.String `tfsdk:"produced_at"`
+	ThisUpdate       types.String `tfsdk:"this_update"`
+	NextUpdate       types.String `tfsdk:"next_update"`
+}
+
+func (d *PKISecretBackendOCSPDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_ocsp"
+}
+
+func (d *PKISecretBackendOCSPDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+			consts.FieldIssuerRef: schema.StringAttribute{
+				MarkdownDescription: "Reference to the issuer of the certificate, either its name or ID. " +
+					"The OCSP response is verified against this issuer. Defaults to `default`.",
+				Optional: true,
+				Computed: true,
+			},
+			consts.FieldSerialNumber: schema.StringAttribute{
+				MarkdownDescription: "The serial number of the certificate, in the hex format used by Vault, " +
+					"with `:` or `-` separators.",
+				Required: true,
+			},
+			consts.FieldStatus: schema.StringAttribute{
+				MarkdownDescription: "The revocation status of the certificate, one of `good`, `revoked` or `unknown`.",
+				Computed:            true,
+			},
+			consts.FieldRevokedAt: schema.StringAttribute{
+				MarkdownDescription: "The time at which the certificate was revoked, in RFC3339 format. " +
+					"Only set when `status` is `revoked`.",
+				Computed: true,
+			},
+			consts.FieldRevocationReason: schema.Int64Attribute{
+				MarkdownDescription: "The RFC 5280 reason code of the revocation. Only set when `status` is `revoked`.",
+				Computed:            true,
+			},
+			consts.FieldProducedAt: schema.StringAttribute{
+				MarkdownDescription: "The time at which the OCSP response was signed, in RFC3339 format.",
+				Computed:            true,
+			},
+			consts.FieldThisUpdate: schema.StringAttribute{
+				MarkdownDescription: "The time at which the status was known to be correct, in RFC3339 format.",
+				Computed:            true,
+			},
+			consts.FieldNextUpdate: schema.StringAttribute{
+				MarkdownDescription: "The time at or before which newer information will be available, in RFC3339 format.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Queries the OCSP responder of a PKI secrets engine for the revocation status of a certificate.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *PKISecretBackendOCSPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PKISecretBackendOCSPModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serial, err := parseSerialNumber(data.SerialNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid serial number", err.Error())
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if data.IssuerRef.IsNull() || data.IssuerRef.IsUnknown() {
+		data.IssuerRef = types.StringValue(defaultIssuerRef)
+	}
+
+	issuer, err := readIssuerCertificate(ctx, cli, data.Mount.ValueString(), data.IssuerRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	ocspResp, err := queryOCSP(ctx, cli, data.Mount.ValueString(), serial, issuer)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	data.RevokedAt = types.StringNull()
+	data.RevocationReason = types.Int64Null()
+	switch ocspResp.Status {
+	case ocsp.Good:
+		data.Status = types.StringValue(ocspStatusGood)
+	case ocsp.Revoked:
+		data.Status = types.StringValue(ocspStatusRevoked)
+		data.RevokedAt = types.StringValue(ocspResp.RevokedAt.UTC().Format(time.RFC3339))
+		data.RevocationReason = types.Int64Value(int64(ocspResp.RevocationReason))
+	default:
+		data.Status = types.StringValue(ocspStatusUnknown)
+	}
+
+	data.ProducedAt = types.StringValue(ocspResp.ProducedAt.UTC().Format(time.RFC3339))
+	data.ThisUpdate = types.StringValue(ocspResp.ThisUpdate.UTC().Format(time.RFC3339))
+	data.NextUpdate = types.StringNull()
+	if !ocspResp.NextUpdate.IsZero() {
+		data.NextUpdate = types.StringValue(ocspResp.NextUpdate.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// queryOCSP sends an OCSP request for the certificate serial issued by issuer
+// to the OCSP responder of mount. The signature of the response is verified
+// against issuer.
+func queryOCSP(ctx context.Context, cli *api.Client, mount string, serial *big.Int, issuer *x509.Certificate) (*ocsp.Response, error) {
+	cert := &x509.Certificate{
+		SerialNumber: serial,
+	}
+
+	body, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCSP request: %w", err)
+	}
+
+	path := fmt.Sprintf("/v1/%s/ocsp", mount)
+	r := cli.NewRequest(http.MethodPost, path)
+	if r.Headers == nil {
+		r.Headers = http.Header{}
+	}
+	r.Headers.Set("Content-Type", "application/ocsp-request")
+	r.BodyBytes = body
+
+	tflog.Debug(ctx, "Querying PKI OCSP responder", map[string]any{consts.FieldPath: path})
+	resp, err := cli.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	ocspResp, err := ocsp.ParseResponseForCert(der, cert, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCSP response: %w", err)
+	}
+
+	return ocspResp, nil
+}
+
+// parseSerialNumber parses a hex encoded serial number, with optional ':' or
+// '-' separators.
+func parseSerialNumber(s string) (*big.Int, error) {
+	b, err := hex.DecodeString(strings.NewReplacer(":", "", "-", "").Replace(s))
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("%q is not a hex encoded serial number", s)
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
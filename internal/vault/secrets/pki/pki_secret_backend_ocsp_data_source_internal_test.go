@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseSerialNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		serial  string
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name:   "colon-separated",
+			serial: "39:dd:2e:90:b7:23:1f:8d:d3:7d:31:c5:1b:da:84:d0:5b:65:31:58",
+			want:   mustBigInt(t, "39dd2e90b7231f8dd37d31c51bda84d05b653158"),
+		},
+		{
+			name:   "dash-separated",
+			serial: "39-dd-2e-90",
+			want:   mustBigInt(t, "39dd2e90"),
+		},
+		{
+			name:   "no-separators",
+			serial: "0A0B",
+			want:   big.NewInt(0x0a0b),
+		},
+		{
+			name:   "leading-zero",
+			serial: "00:01",
+			want:   big.NewInt(1),
+		},
+		{
+			name:    "empty",
+			serial:  "",
+			wantErr: true,
+		},
+		{
+			name:    "separators-only",
+			serial:  "::",
+			wantErr: true,
+		},
+		{
+			name:    "not-hex",
+			serial:  "zz:01",
+			wantErr: true,
+		},
+		{
+			name:    "odd-length",
+			serial:  "1:02",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSerialNumber(tt.serial)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("parseSerialNumber(%q) = %x, want %x", tt.serial, got, tt.want)
+			}
+		})
+	}
+}
+
+func mustBigInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+
+	i, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		t.Fatalf("invalid hex number %q", s)
+	}
+
+	return i
+}
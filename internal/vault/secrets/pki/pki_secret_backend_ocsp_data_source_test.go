@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPKISecretBackendOCSPDataSource(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	goodDataSource := "data.vault_pki_secret_backend_ocsp.good"
+	revokedDataSource := "data.vault_pki_secret_backend_ocsp.revoked"
+
+	var serial string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendOCSPDataSourceConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vault_pki_secret_backend_cert.revoked", consts.FieldSerialNumber, func(v string) error {
+						serial = v
+						return nil
+					}),
+					resource.TestCheckResourceAttr(goodDataSource, consts.FieldIssuerRef, "default"),
+					resource.TestCheckResourceAttr(goodDataSource, consts.FieldStatus, "good"),
+					resource.TestCheckResourceAttrSet(goodDataSource, consts.FieldProducedAt),
+					resource.TestCheckResourceAttrSet(goodDataSource, consts.FieldThisUpdate),
+					resource.TestCheckNoResourceAttr(goodDataSource, consts.FieldRevokedAt),
+					resource.TestCheckNoResourceAttr(goodDataSource, consts.FieldRevocationReason),
+					resource.TestCheckResourceAttr(revokedDataSource, consts.FieldStatus, "good"),
+					resource.TestCheckNoResourceAttr(revokedDataSource, consts.FieldRevokedAt),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if _, err := client.Logical().Write(fmt.Sprintf("%s/revoke", backend), map[string]interface{}{
+						consts.FieldSerialNumber: serial,
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccPKISecretBackendOCSPDataSourceConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(goodDataSource, consts.FieldStatus, "good"),
+					resource.TestCheckNoResourceAttr(goodDataSource, consts.FieldRevokedAt),
+					resource.TestCheckResourceAttr(revokedDataSource, consts.FieldStatus, "revoked"),
+					resource.TestCheckResourceAttrSet(revokedDataSource, consts.FieldRevokedAt),
+					resource.TestCheckResourceAttrSet(revokedDataSource, consts.FieldRevocationReason),
+					resource.TestCheckResourceAttrSet(revokedDataSource, consts.FieldProducedAt),
+					resource.TestCheckResourceAttrSet(revokedDataSource, consts.FieldThisUpdate),
+				),
+			},
+			{
+				Config: testAccPKISecretBackendOCSPDataSourceConfig(backend) + `
+data "vault_pki_secret_backend_ocsp" "invalid" {
+  mount         = vault_mount.pki.path
+  serial_number = "not-a-serial"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid serial number`),
+			},
+		},
+	})
+}
+
+func testAccPKISecretBackendOCSPDataSourceConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "root" {
+  backend     = vault_mount.pki.path
+  type        = "internal"
+  common_name = "example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.root.backend
+  name             = "test"
+  allowed_domains  = ["example.com"]
+  allow_subdomains = true
+}
+
+resource "vault_pki_secret_backend_cert" "good" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "good.example.com"
+}
+
+resource "vault_pki_secret_backend_cert" "revoked" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "revoked.example.com"
+}
+
+data "vault_pki_secret_backend_ocsp" "good" {
+  mount         = vault_mount.pki.path
+  serial_number = vault_pki_secret_backend_cert.good.serial_number
+}
+
+data "vault_pki_secret_backend_ocsp" "revoked" {
+  mount         = vault_mount.pki.path
+  serial_number = vault_pki_secret_backend_cert.revoked.serial_number
+}
+`, backend)
+}
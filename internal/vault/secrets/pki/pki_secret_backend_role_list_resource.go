@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the list.ListResource interface
+var _ list.ListResourceWithRawV5Schemas = &PKISecretBackendRoleListResource{}
+
+// NewPKISecretBackendRoleListResource returns the implementation for this list resource
+func NewPKISecretBackendRoleListResource() list.ListResource {
+	return &PKISecretBackendRoleListResource{}
+}
+
+// PKISecretBackendRoleListResource lists the roles of a PKI secrets engine
+type PKISecretBackendRoleListResource struct {
+	base.ListResourceWithSDKv2
+}
+
+// PKISecretBackendRoleListModel describes the list resource configuration
+type PKISecretBackendRoleListModel struct {
+	base.BaseModel
+
+	Mount types.String `tfsdk:"mount"`
+}
+
+func (r *PKISecretBackendRoleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_role"
+}
+
+func (r *PKISecretBackendRoleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Lists the roles of a PKI secrets engine.",
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+func (r *PKISecretBackendRoleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PKISecretBackendRoleListModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	path := fmt.Sprintf("%s/roles", mount)
+
+	tflog.Debug(ctx, "Listing PKI roles", map[string]any{consts.FieldPath: path})
+	resp, err := cli.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var results []base.ListResult
+	if resp != nil {
+		keys, ok := resp.Data["keys"].([]interface{})
+		if !ok {
+			diags.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("expected list for %q, got %T", "keys", resp.Data["keys"]),
+			)
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		for _, k := range keys {
+			name := k.(string)
+			results = append(results, base.ListResult{
+				ID:          fmt.Sprintf("%s/%s", path, name),
+				DisplayName: name,
+			})
+		}
+	}
+
+	stream.Results = r.ListResults(ctx, req, data.Namespace.ValueString(), data.Cluster.ValueString(), results)
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+const (
+	tidyStateRunning   = "Running"
+	tidyStateFinished  = "Finished"
+	tidyStateError     = "Error"
+	tidyStateCancelled = "Cancelled"
+
+	defaultTidyTimeout = 10 * time.Minute
+	// tidyStatusPollInterval is the interval at which the tidy status is polled.
+	tidyStatusPollInterval = 2 * time.Second
+)
+
+var pkiSecretBackendTidyBoolFields = map[string]string{
+	consts.FieldTidyCertStore:                     "Set to true to tidy up the certificate store.",
+	consts.FieldTidyRevokedCerts:                  "Set to true to remove all invalid and expired certificates from storage, and from the CRL.",
+	consts.FieldTidyRevokedCertIssuerAssociations: "Set to true to validate issuer associations on revocation entries.",
+	consts.FieldTidyExpiredIssuers:                "Set to true to remove expired issuers past the issuer_safety_buffer. No keys are removed.",
+	consts.FieldTidyMoveLegacyCaBundle:            "Set to true to move the legacy ca_bundle from /config/ca_bundle to /config/ca_bundle.bak.",
+	consts.FieldTidyAcme:                          "Set to true to tidy ACME accounts, orders and authorizations.",
+	consts.FieldTidyRevocationQueue:               "Set to true to remove stale revocation queue entries that haven't been confirmed by any active cluster.",
+	consts.FieldTidyCrossClusterRevokedCerts:      "Set to true to tidy up the cross-cluster revoked certificate store.",
+	consts.FieldTidyCertMetadata:                  "Set to true to tidy up certificate metadata.",
+	consts.FieldTidyCmpv2NonceStore:               "Set to true to tidy up the CMPv2 nonce store.",
+}
+
+var pkiSecretBackendTidyDurationFields = map[string]string{
+	consts.FieldSafetyBuffer:                "The amount of extra time that must have passed beyond certificate expiration before it is removed from the backend storage and/or revocation list.",
+	consts.FieldIssuerSafetyBuffer:          "The amount of extra time that must have passed beyond issuer's expiration before it is removed from the backend storage.",
+	consts.FieldAcmeAccountSafetyBuffer:     "The amount of time that must pass after creation that an account with no orders is marked revoked, and the amount of time after being marked revoked or deactivated.",
+	consts.FieldPauseDuration:               "The amount of time to wait between processing certificates.",
+	consts.FieldRevocationQueueSafetyBuffer: "The amount of time that must pass from the cross-cluster revocation request being initiated to when it will be slated for removal.",
+}
+
+// Ensure the implementation satisfies the action.ActionWithConfigure interface
+var _ action.ActionWithConfigure = &PKISecretBackendTidyAction{}
+
+// NewPKISecretBackendTidyAction returns the implementation for this action
+func NewPKISecretBackendTidyAction() action.Action {
+	return &PKISecretBackendTidyAction{}
+}
+
+// PKISecretBackendTidyAction starts a tidy operation on a PKI secrets engine,
+// and waits for it to complete.
+type PKISecretBackendTidyAction struct {
+	base.ActionWithConfigure
+}
+
+// tidyStatus is the response of the tidy-status endpoint.
+type tidyStatus struct {
+	State       string
+	Error       string
+	Message     string
+	TimeStarted string
+	// Counts holds all the *_count fields of the response.
+	Counts map[string]int64
+}
+
+func (a *PKISecretBackendTidyAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_tidy"
+}
+
+func (a *PKISecretBackendTidyAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a tidy operation on a PKI secrets engine, and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+			consts.FieldTimeout: schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The maximum amount of time to wait for the tidy operation to complete. " +
+					"The tidy operation keeps running in Vault after the timeout. Defaults to `10m`.",
+				Validators: []validator.String{
+					validators.DurationValidator(),
+				},
+			},
+		},
+	}
+
+	for field, desc := range pkiSecretBackendTidyBoolFields {
+		resp.Schema.Attributes[field] = schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: desc,
+		}
+	}
+
+	for field, desc := range pkiSecretBackendTidyDurationFields {
+		resp.Schema.Attributes[field] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: desc,
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		}
+	}
+
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *PKISecretBackendTidyAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var ns, cluster, mount, timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldCluster), &cluster)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldTimeout), &timeout)...)
+
+	data := map[string]interface{}{}
+	for field := range pkiSecretBackendTidyBoolFields {
+		var v types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(field), &v)...)
+		if !v.IsNull() {
+			data[field] = v.ValueBool()
+		}
+	}
+	for field := range pkiSecretBackendTidyDurationFields {
+		var v types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(field), &v)...)
+		if !v.IsNull() {
+			data[field] = v.ValueString()
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wait := defaultTidyTimeout
+	if !timeout.IsNull() {
+		// the value was validated by the DurationValidator
+		wait, _ = time.ParseDuration(timeout.ValueString())
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), ns.ValueString(), cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	// the tidy status is polled for changes, so it must never be cached.
+	c, err = helper.DisableReadCache(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	statusPath := fmt.Sprintf("%s/tidy-status", mount.ValueString())
+	// the status of the previous tidy operation, the tidy operation started
+	// below runs in the background, so its status may not be reported immediately.
+	previous, err := readTidyStatus(ctx, c, statusPath)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	tidyPath := fmt.Sprintf("%s/tidy", mount.ValueString())
+	tflog.Debug(ctx, "Starting PKI tidy operation", map[string]any{
+		consts.FieldPath: tidyPath,
+	})
+	secret, err := c.Logical().WriteWithContext(ctx, tidyPath, data)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultInvokeErr(err))
+		return
+	}
+	if secret != nil {
+		for _, w := range secret.Warnings {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: w,
+			})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	ticker := time.NewTicker(tidyStatusPollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := readTidyStatus(ctx, c, statusPath)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				resp.Diagnostics.AddError(tidyTimeoutErr(wait))
+				return
+			}
+			resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+			return
+		}
+
+		if status.State == tidyStateRunning || status.TimeStarted != previous.TimeStarted {
+			switch status.State {
+			case tidyStateFinished:
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Tidy operation finished: %s", status.formatCounts()),
+				})
+				return
+			case tidyStateError:
+				resp.Diagnostics.AddError(
+					"PKI tidy operation failed",
+					fmt.Sprintf("The tidy operation on %q failed: %s", mount.ValueString(), status.Error),
+				)
+				return
+			case tidyStateCancelled:
+				resp.Diagnostics.AddError(
+					"PKI tidy operation cancelled",
+					fmt.Sprintf("The tidy operation on %q was cancelled: %s", mount.ValueString(), status.formatCounts()),
+				)
+				return
+			case tidyStateRunning:
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Tidy operation running: %s", status.Message),
+				})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError(tidyTimeoutErr(wait))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func tidyTimeoutErr(wait time.Duration) (string, string) {
+	return "Timeout waiting for the PKI tidy operation",
+		fmt.Sprintf("The tidy operation did not complete within %s, it continues to run in Vault.", wait)
+}
+
+func readTidyStatus(ctx context.Context, c *api.Client, statusPath string) (*tidyStatus, error) {
+	secret, err := c.Logical().ReadWithContext(ctx, statusPath)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &tidyStatus{
+		Counts: map[string]int64{},
+	}
+	if secret == nil || secret.Data == nil {
+		return status, nil
+	}
+
+	status.State, _ = secret.Data["state"].(string)
+	status.Error, _ = secret.Data["error"].(string)
+	status.Message, _ = secret.Data["message"].(string)
+	status.TimeStarted, _ = secret.Data["time_started"].(string)
+
+	for k, v := range secret.Data {
+		if !strings.HasSuffix(k, "_count") {
+			continue
+		}
+		if n, ok := v.(json.Number); ok {
+			if i, err := n.Int64(); err == nil {
+				status.Counts[k] = i
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// formatCounts returns the counts of the tidy status, in the form
+// "cert_store_deleted_count=1, revoked_cert_deleted_count=0".
+func (s *tidyStatus) formatCounts() string {
+	keys := make([]string, 0, len(s.Counts))
+	for k := range s.Counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	counts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		counts = append(counts, fmt.Sprintf("%s=%d", k, s.Counts[k]))
+	}
+
+	return strings.Join(counts, ", ")
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPKISecretBackendTidyAction(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendTidyActionConfig(backend),
+				Check: func(s *terraform.State) error {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						return err
+					}
+
+					resp, err := client.Logical().Read(fmt.Sprintf("%s/tidy-status", backend))
+					if err != nil {
+						return err
+					}
+					if resp == nil {
+						return fmt.Errorf("tidy status of %q not found", backend)
+					}
+
+					// the action only returns once the tidy operation is complete.
+					if state := resp.Data["state"]; state != "Finished" {
+						return fmt.Errorf("expected the tidy operation to be finished, state is %q", state)
+					}
+					if v := resp.Data["tidy_cert_store"]; v != true {
+						return fmt.Errorf("expected tidy_cert_store to be true, actual %v", v)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccPKISecretBackendTidyActionConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "root" {
+  backend     = vault_mount.pki.path
+  type        = "internal"
+  common_name = "example.com"
+  ttl         = "86400"
+}
+
+action "vault_pki_secret_backend_tidy" "tidy" {
+  config {
+    mount              = vault_mount.pki.path
+    tidy_cert_store    = true
+    tidy_revoked_certs = true
+    safety_buffer      = "1s"
+    timeout            = "2m"
+  }
+}
+
+resource "terraform_data" "tidy" {
+  input = vault_pki_secret_backend_root_cert.root.serial_number
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_pki_secret_backend_tidy.tidy]
+    }
+  }
+}
+`, backend)
+}
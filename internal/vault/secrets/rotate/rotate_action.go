@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rotate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+var _ action.ActionWithConfigure = &RotateAction{}
+
+// NewAWSRotateRootAction returns the action that rotates the root credentials
+// of an AWS secrets engine.
+func NewAWSRotateRootAction() action.Action {
+	return &RotateAction{
+		typeName:    "_aws_rotate_root",
+		description: "Rotates the root IAM credentials of an AWS secrets engine.",
+		engine:      "AWS",
+		pathFormat:  "%s/config/rotate-root",
+	}
+}
+
+// NewAzureRotateRootAction returns the action that rotates the client secret
+// of an Azure secrets engine.
+func NewAzureRotateRootAction() action.Action {
+	return &RotateAction{
+		typeName:    "_azure_rotate_root",
+		description: "Rotates the client secret of the service principal that is used by an Azure secrets engine.",
+		engine:      "Azure",
+		pathFormat:  "%s/rotate-root",
+	}
+}
+
+// NewDatabaseRotateRootAction returns the action that rotates the root
+// credentials of a database secrets engine connection.
+func NewDatabaseRotateRootAction() action.Action {
+	return &RotateAction{
+		typeName:    "_database_rotate_root",
+		description: "Rotates the root credentials of a database secrets engine connection.",
+		engine:      "database",
+		pathFormat:  "%s/rotate-root/%s",
+		fields: []field{
+			{
+				name:        consts.FieldName,
+				description: "Name of the database connection.",
+			},
+		},
+	}
+}
+
+// NewGCPRotateRootAction returns the action that rotates the service account
+// key of a GCP secrets engine.
+func NewGCPRotateRootAction() action.Action {
+	return &RotateAction{
+		typeName:    "_gcp_rotate_root",
+		description: "Rotates the key of the service account that is used by a GCP secrets engine.",
+		engine:      "GCP",
+		pathFormat:  "%s/config/rotate-root",
+	}
+}
+
+// NewLDAPRotateRootAction returns the action that rotates the bind password
+// of an LDAP secrets engine.
+func NewLDAPRotateRootAction() action.Action {
+	return &RotateAction{
+		typeName:    "_ldap_rotate_root",
+		description: "Rotates the bind password of an LDAP secrets engine.",
+		engine:      "LDAP",
+		pathFormat:  "%s/rotate-root",
+	}
+}
+
+// NewLDAPStaticRoleRotateAction returns the action that rotates the password
+// of an LDAP secrets engine static role.
+func NewLDAPStaticRoleRotateAction() action.Action {
+	return &RotateAction{
+		typeName:    "_ldap_static_role_rotate",
+		description: "Rotates the password of an LDAP secrets engine static role.",
+		engine:      "LDAP",
+		pathFormat:  "%s/rotate-role/%s",
+		fields: []field{
+			{
+				name:        consts.FieldName,
+				description: "Name of the static role.",
+			},
+		},
+	}
+}
+
+// NewTerraformCloudRotateRootAction returns the action that rotates the token
+// of a Terraform Cloud secrets engine.
+func NewTerraformCloudRotateRootAction() action.Action {
+	return &RotateAction{
+		typeName:    "_terraform_cloud_rotate_root",
+		description: "Rotates the token that is used by a Terraform Cloud secrets engine.",
+		engine:      "Terraform Cloud",
+		pathFormat:  "%s/rotate-root",
+	}
+}
+
+// NewOSAccountRotateAction returns the action that rotates the password of an
+// OS secrets engine account.
+func NewOSAccountRotateAction() action.Action {
+	return &RotateAction{
+		typeName:    "_os_secret_backend_account_rotate",
+		description: "Rotates the password of an OS secrets engine account.",
+		engine:      "OS",
+		pathFormat:  "%s/hosts/%s/accounts/%s/rotate",
+		fields: []field{
+			{
+				name:        consts.FieldHost,
+				description: "Name of the host of the account.",
+			},
+			{
+				name:        consts.FieldName,
+				description: "Name of the account.",
+			},
+		},
+	}
+}
+
+// field is a required attribute of a RotateAction, that is part of the path
+// of its rotate endpoint.
+type field struct {
+	name        string
+	description string
+}
+
+// RotateAction implements an action that triggers the immediate rotation of
+// credentials managed by a secrets engine. The rotate endpoint is built from
+// pathFormat, with the mount followed by the values of fields.
+type RotateAction struct {
+	base.ActionWithConfigure
+
+	typeName    string
+	description string
+	engine      string
+	pathFormat  string
+	fields      []field
+}
+
+// Metadata defines the action name as it would appear in Terraform configurations.
+func (a *RotateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + a.typeName
+}
+
+// Schema defines this action's schema.
+func (a *RotateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: a.description,
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("Path where the %s secrets engine is mounted.", a.engine),
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+		},
+	}
+
+	for _, f := range a.fields {
+		resp.Schema.Attributes[f.name] = schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: f.description,
+		}
+	}
+
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+// Invoke writes to the rotate endpoint.
+func (a *RotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var ns, cluster, mount types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldCluster), &cluster)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+
+	args := []any{mount.ValueString()}
+	for _, f := range a.fields {
+		var v types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(f.name), &v)...)
+		args = append(args, v.ValueString())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), ns.ValueString(), cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	rotatePath := fmt.Sprintf(a.pathFormat, args...)
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rotating credentials at %q", rotatePath),
+	})
+
+	tflog.Debug(ctx, "Rotating credentials", map[string]any{
+		consts.FieldPath: rotatePath,
+	})
+	if _, err := c.Logical().WriteWithContext(ctx, rotatePath, nil); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultInvokeErr(err))
+		return
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rotate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccLDAPStaticRoleRotateAction(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-ldap")
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+	role := "alice"
+
+	var lastRotation string
+	readLastRotation := func(s *terraform.State) (string, error) {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := client.Logical().Read(fmt.Sprintf("%s/static-role/%s", mount, role))
+		if err != nil {
+			return "", err
+		}
+		if resp == nil {
+			return "", fmt.Errorf("static role %q not found", role)
+		}
+
+		v, _ := resp.Data["last_vault_rotation"].(string)
+		return v, nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPStaticRoleRotateActionConfig(mount, bindDN, bindPass, url, role, false),
+				Check: func(s *terraform.State) error {
+					v, err := readLastRotation(s)
+					lastRotation = v
+					return err
+				},
+			},
+			{
+				Config: testAccLDAPStaticRoleRotateActionConfig(mount, bindDN, bindPass, url, role, true),
+				Check: func(s *terraform.State) error {
+					v, err := readLastRotation(s)
+					if err != nil {
+						return err
+					}
+					if v == lastRotation {
+						return fmt.Errorf("expected the static role to be rotated, last_vault_rotation is still %q", v)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccLDAPStaticRoleRotateActionConfig(mount, bindDN, bindPass, url, role string, rotate bool) string {
+	config := fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+  url      = "%s"
+  userdn   = "CN=Users,DC=corp,DC=example,DC=net"
+}
+
+resource "vault_ldap_secret_backend_static_role" "role" {
+  mount           = vault_ldap_secret_backend.test.path
+  username        = "%s"
+  dn              = "cn=%s,ou=users,dc=example,dc=org"
+  role_name       = "%s"
+  rotation_period = 3600
+}
+
+action "vault_ldap_static_role_rotate" "role" {
+  config {
+    mount = vault_ldap_secret_backend.test.path
+    name  = vault_ldap_secret_backend_static_role.role.role_name
+  }
+}
+`, mount, bindDN, bindPass, url, role, role, role)
+
+	if rotate {
+		config += `
+resource "terraform_data" "rotate" {
+  input = vault_ldap_secret_backend_static_role.role.role_name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_ldap_static_role_rotate.role]
+    }
+  }
+}
+`
+	}
+
+	return config
+}
@@ -132,7 +132,7 @@ func (s *SpiffeSecretBackendConfigResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -173,7 +173,7 @@ func (s *SpiffeSecretBackendConfigResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -207,7 +207,7 @@ func (s *SpiffeSecretBackendConfigResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -267,6 +267,8 @@ func (s *SpiffeSecretBackendConfigResource) ImportState(ctx context.Context, req
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (s *SpiffeSecretBackendConfigResource) path(mount string) string {
@@ -92,7 +92,7 @@ func (s *SpiffeSecretBackendMintJwtResource) Open(ctx context.Context, req ephem
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
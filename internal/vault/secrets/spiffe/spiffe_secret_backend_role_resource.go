@@ -110,7 +110,7 @@ func (s *SpiffeSecretBackendRoleResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -157,7 +157,7 @@ func (s *SpiffeSecretBackendRoleResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -197,7 +197,7 @@ func (s *SpiffeSecretBackendRoleResource) Update(ctx context.Context, req resour
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -244,7 +244,7 @@ func (s *SpiffeSecretBackendRoleResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, s.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -284,6 +284,8 @@ func (s *SpiffeSecretBackendRoleResource) ImportState(ctx context.Context, req r
 			resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (s *SpiffeSecretBackendRoleResource) path(data *SpiffeSecretBackendRoleModel) (string, error) {
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+var _ ephemeral.EphemeralResource = &SSHSecretBackendIssueEphemeralResource{}
+
+var NewSSHSecretBackendIssueEphemeralResource = func() ephemeral.EphemeralResource {
+	return &SSHSecretBackendIssueEphemeralResource{}
+}
+
+// SSHSecretBackendIssueEphemeralResource generates a new SSH key pair, and
+// signs its public key with the CA of an SSH secrets engine.
+type SSHSecretBackendIssueEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+type SSHSecretBackendIssueModel struct {
+	base.BaseModelEphemeral
+	base.BaseModelWrapping
+
+	Mount           types.String `tfsdk:"mount"`
+	Role            types.String `tfsdk:"role"`
+	KeyType         types.String `tfsdk:"key_type"`
+	KeyBits         types.Int64  `tfsdk:"key_bits"`
+	TTL             types.String `tfsdk:"ttl"`
+	ValidPrincipals types.String `tfsdk:"valid_principals"`
+	CertType        types.String `tfsdk:"cert_type"`
+	KeyID           types.String `tfsdk:"key_id"`
+	CriticalOptions types.Map    `tfsdk:"critical_options"`
+	Extensions      types.Map    `tfsdk:"extensions"`
+
+	// Computed
+	SerialNumber   types.String `tfsdk:"serial_number"`
+	SignedKey      types.String `tfsdk:"signed_key"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	PrivateKeyType types.String `tfsdk:"private_key_type"`
+}
+
+type sshSecretBackendIssueAPIModel struct {
+	SerialNumber   string `json:"serial_number"`
+	SignedKey      string `json:"signed_key"`
+	PrivateKey     string `json:"private_key"`
+	PrivateKeyType string `json:"private_key_type"`
+}
+
+func (r *SSHSecretBackendIssueEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the SSH secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldRole: schema.StringAttribute{
+				MarkdownDescription: "Name of the role to issue the certificate against.",
+				Required:            true,
+			},
+			consts.FieldKeyType: schema.StringAttribute{
+				MarkdownDescription: "Type of the key pair to generate, one of `rsa`, `ec` or `ed25519`. Defaults to `rsa`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("rsa", "ec", "ed25519"),
+				},
+			},
+			consts.FieldKeyBits: schema.Int64Attribute{
+				MarkdownDescription: "Number of bits of the key pair to generate. Ignored for `ed25519` keys. " +
+					"Defaults to 4096 for `rsa` and 256 for `ec` keys.",
+				Optional: true,
+			},
+			consts.FieldTTL: schema.StringAttribute{
+				MarkdownDescription: "Requested Time To Live of the certificate. Cannot be greater than the role's `max_ttl`.",
+				Optional:            true,
+			},
+			consts.FieldValidPrincipals: schema.StringAttribute{
+				MarkdownDescription: "Comma separated list of usernames or hostnames that the certificate should be signed for.",
+				Optional:            true,
+			},
+			consts.FieldCertType: schema.StringAttribute{
+				MarkdownDescription: "Type of certificate to create, either `user` or `host`. Defaults to `user`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "host"),
+				},
+			},
+			consts.FieldKeyID: schema.StringAttribute{
+				MarkdownDescription: "Key ID of the certificate. Defaults to the display name of the token.",
+				Optional:            true,
+			},
+			consts.FieldCriticalOptions: schema.MapAttribute{
+				MarkdownDescription: "Critical options that the certificate should be signed for.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			consts.FieldExtensions: schema.MapAttribute{
+				MarkdownDescription: "Extensions that the certificate should be signed for.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			consts.FieldSerialNumber: schema.StringAttribute{
+				MarkdownDescription: "Serial number of the certificate.",
+				Computed:            true,
+			},
+			consts.FieldSignedKey: schema.StringAttribute{
+				MarkdownDescription: "The signed SSH certificate.",
+				Computed:            true,
+			},
+			consts.FieldPrivateKey: schema.StringAttribute{
+				MarkdownDescription: "The generated private key, in OpenSSH format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldPrivateKeyType: schema.StringAttribute{
+				MarkdownDescription: "Type of the generated private key.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Generates an SSH key pair and signs its public key with the CA of an SSH secrets engine. " +
+			"The private key is never stored in the Terraform state.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
+}
+
+func (r *SSHSecretBackendIssueEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_issue"
+}
+
+func (r *SSHSecretBackendIssueEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SSHSecretBackendIssueModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	reqData := map[string]any{}
+	for k, v := range map[string]types.String{
+		consts.FieldKeyType:         data.KeyType,
+		consts.FieldTTL:             data.TTL,
+		consts.FieldValidPrincipals: data.ValidPrincipals,
+		consts.FieldCertType:        data.CertType,
+		consts.FieldKeyID:           data.KeyID,
+	} {
+		if !v.IsNull() {
+			reqData[k] = v.ValueString()
+		}
+	}
+	if !data.KeyBits.IsNull() {
+		reqData[consts.FieldKeyBits] = data.KeyBits.ValueInt64()
+	}
+	for k, v := range map[string]types.Map{
+		consts.FieldCriticalOptions: data.CriticalOptions,
+		consts.FieldExtensions:      data.Extensions,
+	} {
+		if v.IsNull() {
+			continue
+		}
+		var m map[string]string
+		resp.Diagnostics.Append(v.ElementsAs(ctx, &m, false)...)
+		reqData[k] = m
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := fmt.Sprintf("%s/issue/%s", data.Mount.ValueString(), data.Role.ValueString())
+
+	tflog.Debug(ctx, "Issuing SSH certificate", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().WriteWithContext(ctx, path, reqData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	var apiResp sshSecretBackendIssueAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.SerialNumber = types.StringValue(apiResp.SerialNumber)
+	data.SignedKey = types.StringValue(apiResp.SignedKey)
+	data.PrivateKey = types.StringValue(apiResp.PrivateKey)
+	data.PrivateKeyType = types.StringValue(apiResp.PrivateKeyType)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+var (
+	_ ephemeral.EphemeralResource          = &SSHSecretBackendOTPEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose = &SSHSecretBackendOTPEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew = &SSHSecretBackendOTPEphemeralResource{}
+)
+
+var NewSSHSecretBackendOTPEphemeralResource = func() ephemeral.EphemeralResource {
+	return &SSHSecretBackendOTPEphemeralResource{}
+}
+
+// SSHSecretBackendOTPEphemeralResource generates a one-time password from an
+// OTP role of an SSH secrets engine. The OTP's lease is revoked on Close.
+type SSHSecretBackendOTPEphemeralResource struct {
+	base.EphemeralResourceWithLease
+}
+
+type SSHSecretBackendOTPModel struct {
+	base.BaseModelEphemeral
+	base.BaseModelWrapping
+
+	Mount    types.String `tfsdk:"mount"`
+	Role     types.String `tfsdk:"role"`
+	IP       types.String `tfsdk:"ip"`
+	Username types.String `tfsdk:"username"`
+
+	// Computed
+	Key            types.String `tfsdk:"key"`
+	KeyType        types.String `tfsdk:"key_type"`
+	Port           types.Int64  `tfsdk:"port"`
+	LeaseID        types.String `tfsdk:"lease_id"`
+	LeaseDuration  types.Int64  `tfsdk:"lease_duration"`
+	LeaseStartTime types.String `tfsdk:"lease_start_time"`
+	LeaseRenewable types.Bool   `tfsdk:"lease_renewable"`
+}
+
+type sshSecretBackendOTPAPIModel struct {
+	Key      string `json:"key"`
+	KeyType  string `json:"key_type"`
+	Username string `json:"username"`
+	Port     int64  `json:"port"`
+}
+
+func (r *SSHSecretBackendOTPEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the SSH secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldRole: schema.StringAttribute{
+				MarkdownDescription: "Name of the OTP role to generate the one-time password from.",
+				Required:            true,
+			},
+			consts.FieldIP: schema.StringAttribute{
+				MarkdownDescription: "IP address of the remote host.",
+				Required:            true,
+			},
+			consts.FieldUsername: schema.StringAttribute{
+				MarkdownDescription: "Username on the remote host. Defaults to the role's `default_user`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			consts.FieldKey: schema.StringAttribute{
+				MarkdownDescription: "The one-time password.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldKeyType: schema.StringAttribute{
+				MarkdownDescription: "Type of the credentials, always `otp`.",
+				Computed:            true,
+			},
+			consts.FieldPort: schema.Int64Attribute{
+				MarkdownDescription: "SSH port of the remote host.",
+				Computed:            true,
+			},
+			consts.FieldLeaseID: schema.StringAttribute{
+				MarkdownDescription: "Lease identifier assigned by Vault.",
+				Computed:            true,
+			},
+			consts.FieldLeaseDuration: schema.Int64Attribute{
+				MarkdownDescription: "Lease duration in seconds relative to the time in lease_start_time.",
+				Computed:            true,
+			},
+			consts.FieldLeaseStartTime: schema.StringAttribute{
+				MarkdownDescription: "Time at which the lease was read, using the clock of the system where Terraform was running.",
+				Computed:            true,
+			},
+			consts.FieldLeaseRenewable: schema.BoolAttribute{
+				MarkdownDescription: "True if the duration of this lease can be extended through renewal.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Generates a one-time password from an OTP role of an SSH secrets engine. " +
+			"The one-time password is never stored in the Terraform state, and is revoked once it is no longer needed.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+	base.MustAddWrappingEphemeralSchema(&resp.Schema)
+}
+
+func (r *SSHSecretBackendOTPEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_otp"
+}
+
+func (r *SSHSecretBackendOTPEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SSHSecretBackendOTPModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	reqData := map[string]any{
+		consts.FieldIP: data.IP.ValueString(),
+	}
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
+		reqData[consts.FieldUsername] = data.Username.ValueString()
+	}
+
+	path := fmt.Sprintf("%s/creds/%s", data.Mount.ValueString(), data.Role.ValueString())
+
+	tflog.Debug(ctx, "Generating SSH one-time password", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().WriteWithContext(ctx, path, reqData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	// A wrapped response only holds the wrapping token
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	var apiResp sshSecretBackendOTPAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.Key = types.StringValue(apiResp.Key)
+	data.KeyType = types.StringValue(apiResp.KeyType)
+	data.Username = types.StringValue(apiResp.Username)
+	data.Port = types.Int64Value(apiResp.Port)
+
+	data.LeaseID = types.StringValue(secret.LeaseID)
+	data.LeaseDuration = types.Int64Value(int64(secret.LeaseDuration))
+	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
+	data.LeaseRenewable = types.BoolValue(secret.Renewable)
+
+	r.SetLease(ctx, resp, secret, data.Namespace.ValueString(), data.Cluster.ValueString())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
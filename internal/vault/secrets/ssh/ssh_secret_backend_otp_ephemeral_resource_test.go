@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccSSHSecretBackendOTP(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendOTPConfig(backend),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.otp", tfjsonpath.New("data").AtMapKey("key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.otp", tfjsonpath.New("data").AtMapKey("key_type"), knownvalue.StringExact("otp")),
+					statecheck.ExpectKnownValue("echo.otp", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("ubuntu")),
+					statecheck.ExpectKnownValue("echo.otp", tfjsonpath.New("data").AtMapKey("port"), knownvalue.Int64Exact(22)),
+					statecheck.ExpectKnownValue("echo.otp", tfjsonpath.New("data").AtMapKey("lease_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendOTPConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "ssh" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "otp" {
+  name         = "otp"
+  backend      = vault_mount.ssh.path
+  key_type     = "otp"
+  default_user = "ubuntu"
+  cidr_list    = "10.0.0.0/24"
+}
+
+ephemeral "vault_ssh_secret_backend_otp" "test" {
+  mount_id = vault_ssh_secret_backend_role.otp.id
+  mount    = vault_mount.ssh.path
+  role     = vault_ssh_secret_backend_role.otp.name
+  ip       = "10.0.0.10"
+}
+
+provider "echo" {
+  alias = "otp"
+  data  = ephemeral.vault_ssh_secret_backend_otp.test
+}
+
+resource "echo" "otp" {
+  provider = echo.otp
+}
+`, backend)
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+// Ensure the implementation satisfies the action.ActionWithConfigure interface
+var _ action.ActionWithConfigure = &SSHSecretBackendOTPVerifyAction{}
+
+// NewSSHSecretBackendOTPVerifyAction returns the implementation for this action
+func NewSSHSecretBackendOTPVerifyAction() action.Action {
+	return &SSHSecretBackendOTPVerifyAction{}
+}
+
+// SSHSecretBackendOTPVerifyAction verifies a one-time password generated by
+// an SSH secrets engine. A successful verification consumes the one-time
+// password, so it is only done when the action is invoked, never at plan.
+type SSHSecretBackendOTPVerifyAction struct {
+	base.ActionWithConfigure
+}
+
+type sshSecretBackendOTPVerifyAPIModel struct {
+	Username string `json:"username"`
+	IP       string `json:"ip"`
+	RoleName string `json:"role_name"`
+}
+
+func (a *SSHSecretBackendOTPVerifyAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_otp_verify"
+}
+
+func (a *SSHSecretBackendOTPVerifyAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Verifies a one-time password generated by an SSH secrets engine. " +
+			"A successful verification consumes the one-time password.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path where the SSH secrets engine is mounted.",
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+			},
+			consts.FieldOTP: schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				MarkdownDescription: "The one-time password to verify.",
+			},
+		},
+	}
+
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *SSHSecretBackendOTPVerifyAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var ns, cluster, mount, otp types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldCluster), &cluster)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldOTP), &otp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), ns.ValueString(), cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	verifyPath := fmt.Sprintf("%s/verify", mount.ValueString())
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Verifying one-time password at %q", verifyPath),
+	})
+
+	tflog.Debug(ctx, "Verifying SSH one-time password", map[string]any{
+		consts.FieldPath: verifyPath,
+	})
+	secret, err := c.Logical().WriteWithContext(ctx, verifyPath, map[string]any{
+		consts.FieldOTP: otp.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultInvokeErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var apiResp sshSecretBackendOTPVerifyAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Verified one-time password of user %q on %q from role %q",
+			apiResp.Username, apiResp.IP, apiResp.RoleName),
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccSSHSecretBackendOTPVerifyAction(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// the one-time password is generated and verified during
+				// apply, planning does not consume it.
+				Config: testAccSSHSecretBackendOTPVerifyActionConfig(backend,
+					"ephemeral.vault_ssh_secret_backend_otp.test.key"),
+			},
+			{
+				Config: testAccSSHSecretBackendOTPVerifyActionConfig(backend,
+					`"00000000-0000-0000-0000-000000000000"`),
+				ExpectError: regexp.MustCompile(`OTP not found`),
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendOTPVerifyActionConfig(backend, otp string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "ssh" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "otp" {
+  name         = "otp"
+  backend      = vault_mount.ssh.path
+  key_type     = "otp"
+  default_user = "ubuntu"
+  cidr_list    = "10.0.0.0/24"
+}
+
+ephemeral "vault_ssh_secret_backend_otp" "test" {
+  mount_id = vault_ssh_secret_backend_role.otp.id
+  mount    = vault_mount.ssh.path
+  role     = vault_ssh_secret_backend_role.otp.name
+  ip       = "10.0.0.10"
+}
+
+action "vault_ssh_secret_backend_otp_verify" "verify" {
+  config {
+    mount = vault_mount.ssh.path
+    otp   = %s
+  }
+}
+
+resource "terraform_data" "verify" {
+  input = %q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_ssh_secret_backend_otp_verify.verify]
+    }
+  }
+}
+`, backend, otp, otp)
+}
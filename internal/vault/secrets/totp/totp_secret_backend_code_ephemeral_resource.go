@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package totp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+var _ ephemeral.EphemeralResource = &TOTPSecretBackendCodeEphemeralResource{}
+
+var NewTOTPSecretBackendCodeEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TOTPSecretBackendCodeEphemeralResource{}
+}
+
+// TOTPSecretBackendCodeEphemeralResource generates the current code of a key
+// of the TOTP secrets engine.
+type TOTPSecretBackendCodeEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+type TOTPSecretBackendCodeModel struct {
+	base.BaseModelEphemeral
+
+	Mount types.String `tfsdk:"mount"`
+	Name  types.String `tfsdk:"name"`
+
+	// Computed
+	Code types.String `tfsdk:"code"`
+}
+
+type totpSecretBackendCodeAPIModel struct {
+	Code string `json:"code"`
+}
+
+func (r *TOTPSecretBackendCodeEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the TOTP secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the key to generate a code for.",
+				Required:            true,
+			},
+			consts.FieldCode: schema.StringAttribute{
+				MarkdownDescription: "The current code of the key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+		MarkdownDescription: "Generates the current code of a key of the TOTP secrets engine.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TOTPSecretBackendCodeEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_totp_secret_backend_code"
+}
+
+func (r *TOTPSecretBackendCodeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TOTPSecretBackendCodeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	// the code changes every period, so it must never be cached.
+	c, err = helper.DisableReadCache(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/code/%s", data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Generating TOTP code", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var apiResp totpSecretBackendCodeAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.Code = types.StringValue(apiResp.Code)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package totp_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccTOTPSecretBackendCode(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendCodeConfig(backend),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("code"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9]{8}$`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccTOTPSecretBackendCodeConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  mount        = vault_mount.totp.path
+  name         = "service"
+  generate     = true
+  issuer       = "Example"
+  account_name = "svc@example.com"
+  digits       = 8
+}
+
+ephemeral "vault_totp_secret_backend_code" "test" {
+  depends_on = [vault_totp_secret_backend_key.test]
+
+  mount_id = vault_mount.totp.id
+  mount    = vault_mount.totp.path
+  name     = vault_totp_secret_backend_key.test.name
+}
+
+provider "echo" {
+  data = ephemeral.vault_totp_secret_backend_code.test
+}
+
+resource "echo" "test" {}
+`, backend)
+}
@@ -0,0 +1,474 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package totp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+)
+
+var keyIDRe = regexp.MustCompile(`^(.+)/keys/([^/]+)$`)
+
+var (
+	_ resource.ResourceWithConfigure   = &TOTPSecretBackendKeyResource{}
+	_ resource.ResourceWithImportState = &TOTPSecretBackendKeyResource{}
+)
+
+// NewTOTPSecretBackendKeyResource returns the implementation for this resource
+func NewTOTPSecretBackendKeyResource() resource.Resource {
+	return &TOTPSecretBackendKeyResource{}
+}
+
+// TOTPSecretBackendKeyResource manages a key of the TOTP secrets engine, either
+// generated by Vault or imported from an existing key or otpauth URL.
+type TOTPSecretBackendKeyResource struct {
+	base.ResourceWithConfigure
+}
+
+// TOTPSecretBackendKeyModel describes the Terraform resource data model
+type TOTPSecretBackendKeyModel struct {
+	base.BaseModel
+
+	Mount       types.String `tfsdk:"mount"`
+	Name        types.String `tfsdk:"name"`
+	Generate    types.Bool   `tfsdk:"generate"`
+	Exported    types.Bool   `tfsdk:"exported"`
+	KeySize     types.Int64  `tfsdk:"key_size"`
+	QRSize      types.Int64  `tfsdk:"qr_size"`
+	KeyWO       types.String `tfsdk:"key_wo"`
+	URLWO       types.String `tfsdk:"url_wo"`
+	Issuer      types.String `tfsdk:"issuer"`
+	AccountName types.String `tfsdk:"account_name"`
+	Period      types.Int64  `tfsdk:"period"`
+	Algorithm   types.String `tfsdk:"algorithm"`
+	Digits      types.Int64  `tfsdk:"digits"`
+	Skew        types.Int64  `tfsdk:"skew"`
+	Barcode     types.String `tfsdk:"barcode"`
+	URL         types.String `tfsdk:"url"`
+}
+
+// totpKeyAPIModel describes the Vault API response for a TOTP key
+type totpKeyAPIModel struct {
+	AccountName string `json:"account_name"`
+	Algorithm   string `json:"algorithm"`
+	Digits      int64  `json:"digits"`
+	Issuer      string `json:"issuer"`
+	Period      int64  `json:"period"`
+}
+
+func (r *TOTPSecretBackendKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_totp_secret_backend_key"
+}
+
+func (r *TOTPSecretBackendKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the TOTP secrets engine is mounted.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.PathValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldGenerate: schema.BoolAttribute{
+				MarkdownDescription: "If set, Vault generates the key. Otherwise the key is imported from " +
+					"`key_wo` or `url_wo`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfPriorBool, replaceIfPriorDescription, replaceIfPriorDescription,
+					),
+				},
+			},
+			consts.FieldExported: schema.BoolAttribute{
+				MarkdownDescription: "If set, the `barcode` and `url` of a generated key are returned on creation. " +
+					"Defaults to `true`. Only used with `generate`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfPriorBool, replaceIfPriorDescription, replaceIfPriorDescription,
+					),
+				},
+			},
+			consts.FieldKeySize: schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes of the generated key. Defaults to `20`. Only used with `generate`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfPriorInt64, replaceIfPriorDescription, replaceIfPriorDescription,
+					),
+				},
+			},
+			consts.FieldQRSize: schema.Int64Attribute{
+				MarkdownDescription: "Pixel size of the square QR code of a generated key. Defaults to `200`. " +
+					"Set to `0` to not generate a QR code. Only used with `generate`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfPriorInt64, replaceIfPriorDescription, replaceIfPriorDescription,
+					),
+				},
+			},
+			consts.FieldKeyWO: schema.StringAttribute{
+				MarkdownDescription: "The base32 encoded shared key to import. Conflicts with `url_wo`.",
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(consts.FieldURLWO)),
+				},
+			},
+			consts.FieldURLWO: schema.StringAttribute{
+				MarkdownDescription: "The `otpauth://` URL of the key to import. Conflicts with `key_wo`.",
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+			},
+			consts.FieldIssuer: schema.StringAttribute{
+				MarkdownDescription: "Name of the key's issuing organization.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldAccountName: schema.StringAttribute{
+				MarkdownDescription: "Name of the account associated with the key.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldPeriod: schema.Int64Attribute{
+				MarkdownDescription: "Length of time in seconds used to generate a counter for the code calculation. " +
+					"Defaults to `30`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldAlgorithm: schema.StringAttribute{
+				MarkdownDescription: "Hashing algorithm used to generate the code. One of `SHA1`, `SHA256` or `SHA512`. " +
+					"Defaults to `SHA1`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SHA1", "SHA256", "SHA512"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldDigits: schema.Int64Attribute{
+				MarkdownDescription: "Number of digits of the generated codes. One of `6` or `8`. Defaults to `6`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(6, 8),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldSkew: schema.Int64Attribute{
+				MarkdownDescription: "Number of delay periods that are allowed when validating a code. One of `0` or `1`. " +
+					"Defaults to `1`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfPriorInt64, replaceIfPriorDescription, replaceIfPriorDescription,
+					),
+				},
+			},
+			consts.FieldBarcode: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded PNG of the QR code of a generated and exported key. " +
+					"Only set on creation.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.FieldURL: schema.StringAttribute{
+				MarkdownDescription: "The `otpauth://` URL of a generated and exported key. Only set on creation.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Manages a key of the TOTP secrets engine, generated by Vault or imported.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+func (r *TOTPSecretBackendKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config TOTPSecretBackendKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Generate.ValueBool() && config.KeyWO.IsNull() && config.URLWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(consts.FieldGenerate),
+			"Missing key",
+			fmt.Sprintf("One of %q or %q must be set when %q is not set.", consts.FieldKeyWO, consts.FieldURLWO, consts.FieldGenerate),
+		)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	data := map[string]interface{}{
+		consts.FieldGenerate: plan.Generate.ValueBool(),
+	}
+	if !config.KeyWO.IsNull() {
+		data[consts.FieldKey] = config.KeyWO.ValueString()
+	}
+	if !config.URLWO.IsNull() {
+		data[consts.FieldURL] = config.URLWO.ValueString()
+	}
+	for field, v := range map[string]types.String{
+		consts.FieldIssuer:      plan.Issuer,
+		consts.FieldAccountName: plan.AccountName,
+		consts.FieldAlgorithm:   plan.Algorithm,
+	} {
+		if !v.IsNull() && !v.IsUnknown() {
+			data[field] = v.ValueString()
+		}
+	}
+	for field, v := range map[string]types.Int64{
+		consts.FieldKeySize: plan.KeySize,
+		consts.FieldQRSize:  plan.QRSize,
+		consts.FieldPeriod:  plan.Period,
+		consts.FieldDigits:  plan.Digits,
+		consts.FieldSkew:    plan.Skew,
+	} {
+		if !v.IsNull() && !v.IsUnknown() {
+			data[field] = v.ValueInt64()
+		}
+	}
+	if !plan.Exported.IsNull() {
+		data[consts.FieldExported] = plan.Exported.ValueBool()
+	}
+
+	keyPath := totpKeyPath(plan.Mount.ValueString(), plan.Name.ValueString())
+
+	tflog.Debug(ctx, "Creating TOTP key", map[string]any{consts.FieldPath: keyPath})
+	secret, err := cli.Logical().WriteWithContext(ctx, keyPath, data)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	// Vault only returns the barcode and URL of a generated and exported key
+	// when it is created, they are kept in state from then on.
+	plan.Barcode = types.StringNull()
+	plan.URL = types.StringNull()
+	if secret != nil {
+		if v, ok := secret.Data[consts.FieldBarcode].(string); ok && v != "" {
+			plan.Barcode = types.StringValue(v)
+		}
+		if v, ok := secret.Data[consts.FieldURL].(string); ok && v != "" {
+			plan.URL = types.StringValue(v)
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TOTPSecretBackendKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TOTPSecretBackendKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Name.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records the creation parameters of an imported key, which Vault
+// does not return. All other changes replace the key.
+func (r *TOTPSecretBackendKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TOTPSecretBackendKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the barcode and URL are unknown in the plan when they are not set in
+	// state, e.g. after an import.
+	plan.Barcode = state.Barcode
+	plan.URL = state.URL
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TOTPSecretBackendKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TOTPSecretBackendKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	keyPath := totpKeyPath(state.Mount.ValueString(), state.Name.ValueString())
+
+	tflog.Debug(ctx, "Deleting TOTP key", map[string]any{consts.FieldPath: keyPath})
+	if _, err := cli.Logical().DeleteWithContext(ctx, keyPath); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+func (r *TOTPSecretBackendKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	matches := keyIDRe.FindStringSubmatch(req.ID)
+	if len(matches) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be in the format '<mount>/keys/<name>', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldMount), matches[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldName), matches[2])...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+
+	base.ImportClusterFromEnv(ctx, resp)
+}
+
+// read refreshes the model from Vault. The name is set to null if the key no
+// longer exists.
+func (r *TOTPSecretBackendKeyResource) read(ctx context.Context, data *TOTPSecretBackendKeyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	keyPath := totpKeyPath(data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Reading TOTP key", map[string]any{consts.FieldPath: keyPath})
+	secret, err := cli.Logical().ReadWithContext(ctx, keyPath)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		return diags
+	}
+	if secret == nil {
+		tflog.Warn(ctx, "TOTP key not found, removing from state", map[string]any{consts.FieldPath: keyPath})
+		data.Name = types.StringNull()
+		return diags
+	}
+
+	var apiModel totpKeyAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+		diags.AddError("Unable to translate Vault response data", err.Error())
+		return diags
+	}
+
+	data.Issuer = types.StringValue(apiModel.Issuer)
+	data.AccountName = types.StringValue(apiModel.AccountName)
+	data.Period = types.Int64Value(apiModel.Period)
+	data.Algorithm = types.StringValue(apiModel.Algorithm)
+	data.Digits = types.Int64Value(apiModel.Digits)
+
+	return diags
+}
+
+const replaceIfPriorDescription = "Changing the value replaces the key, unless it is not set in the prior " +
+	"state, e.g. after an import."
+
+// requiresReplaceIfPriorBool replaces the key when a creation parameter
+// changes, but not when it is set on an imported key, as Vault does not return
+// it.
+func requiresReplaceIfPriorBool(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// requiresReplaceIfPriorInt64 is the int64 counterpart of
+// requiresReplaceIfPriorBool.
+func requiresReplaceIfPriorInt64(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func totpKeyPath(mount, name string) string {
+	return fmt.Sprintf("%s/keys/%s", mount, name)
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package totp_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+const testTOTPKeyResource = "vault_totp_secret_backend_key.test"
+
+func TestAccTOTPSecretBackendKey_generate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyGenerateConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldMount, backend),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldName, "generated"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldIssuer, "Example"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldAccountName, "svc@example.com"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldAlgorithm, "SHA256"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldDigits, "8"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldPeriod, "60"),
+					resource.TestMatchResourceAttr(testTOTPKeyResource, consts.FieldURL,
+						regexp.MustCompile(`^otpauth://totp/Example:svc@example.com\?.*secret=`)),
+					resource.TestCheckResourceAttrSet(testTOTPKeyResource, consts.FieldBarcode),
+				),
+			},
+			{
+				ResourceName:       testTOTPKeyResource,
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s/keys/generated", backend),
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+					for _, field := range []string{consts.FieldGenerate, consts.FieldQRSize, consts.FieldBarcode, consts.FieldURL} {
+						if v, ok := states[0].Attributes[field]; ok {
+							return fmt.Errorf("expected %q to not be set on import, got %q", field, v)
+						}
+					}
+					return nil
+				},
+			},
+			{
+				// the creation parameters Vault does not return are recorded
+				// without replacing the imported key.
+				Config: testAccTOTPSecretBackendKeyGenerateConfig(backend),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testTOTPKeyResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldGenerate, "true"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldQRSize, "100"),
+					resource.TestCheckNoResourceAttr(testTOTPKeyResource, consts.FieldURL),
+					resource.TestCheckNoResourceAttr(testTOTPKeyResource, consts.FieldBarcode),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTOTPSecretBackendKey_import(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTOTPSecretBackendKeyMissingKeyConfig(backend),
+				ExpectError: regexp.MustCompile(`Missing key`),
+			},
+			{
+				Config: testAccTOTPSecretBackendKeyImportConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldName, "imported"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldIssuer, "Vault"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldAccountName, "svc@example.com"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldAlgorithm, "SHA1"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldDigits, "6"),
+					resource.TestCheckResourceAttr(testTOTPKeyResource, consts.FieldPeriod, "30"),
+					resource.TestCheckNoResourceAttr(testTOTPKeyResource, consts.FieldURLWO),
+					resource.TestCheckNoResourceAttr(testTOTPKeyResource, consts.FieldURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccTOTPSecretBackendKeyGenerateConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  mount        = vault_mount.totp.path
+  name         = "generated"
+  generate     = true
+  issuer       = "Example"
+  account_name = "svc@example.com"
+  algorithm    = "SHA256"
+  digits       = 8
+  period       = 60
+  qr_size      = 100
+}
+`, backend)
+}
+
+func testAccTOTPSecretBackendKeyMissingKeyConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  mount = vault_mount.totp.path
+  name  = "imported"
+}
+`, backend)
+}
+
+func testAccTOTPSecretBackendKeyImportConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  mount  = vault_mount.totp.path
+  name   = "imported"
+  url_wo = "otpauth://totp/Vault:svc@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Vault"
+}
+`, backend)
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var batchElemType = types.MapType{ElemType: types.StringType}
+
+func batchInputAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: description + " When this parameter is set, any single-item parameters " +
+			"are ignored and the results are returned in `batch_results`, in the same order as the input.",
+		ElementType: batchElemType,
+		Optional:    true,
+	}
+}
+
+func batchResultsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "The results returned from Vault when using `batch_input`. Items that failed " +
+			"contain an `error` key describing the failure.",
+		ElementType: batchElemType,
+		Computed:    true,
+	}
+}
+
+// batchInput converts the configured batch_input into the request format
+// expected by Vault, converting any of the intFields to integers.
+func batchInput(ctx context.Context, list types.List, intFields []string) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var items []map[string]string
+	diags.Append(list.ElementsAs(ctx, &items, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	raw := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		m := make(map[string]interface{}, len(item))
+		for k, v := range item {
+			m[k] = v
+		}
+		raw = append(raw, m)
+	}
+
+	result, err := util.ConvertBatchInput(raw, intFields)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid %s", consts.FieldBatchInput), err.Error())
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// batchResults converts the batch_results returned by Vault into a list of
+// string maps.
+func batchResults(ctx context.Context, raw interface{}) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	results, err := util.ConvertBatchResults(raw)
+	if err != nil {
+		diags.AddError("Unexpected API response", err.Error())
+		return types.ListNull(batchElemType), diags
+	}
+
+	items := make([]map[string]string, 0, len(results))
+	for _, result := range results {
+		item := make(map[string]string, len(result))
+		for k, v := range result {
+			item[k] = fmt.Sprintf("%v", v)
+		}
+		items = append(items, item)
+	}
+
+	list, d := types.ListValueFrom(ctx, batchElemType, items)
+	diags.Append(d...)
+
+	return list, diags
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// kwpIV is the alternative initial value prefix from RFC 5649.
+var kwpIV = []byte{0xa6, 0x59, 0x59, 0xa6}
+
+// wrapKeyWithPadding wraps key with kek using AES Key Wrap with Padding, as
+// described in RFC 5649.
+func wrapKeyWithPadding(kek, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key to wrap must not be empty")
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	// The alternative initial value is the constant prefix followed by the
+	// length of the unpadded key.
+	aiv := make([]byte, 8)
+	copy(aiv, kwpIV)
+	binary.BigEndian.PutUint32(aiv[4:], uint32(len(key)))
+
+	padded := make([]byte, (len(key)+7)/8*8)
+	copy(padded, key)
+
+	if len(padded) == 8 {
+		out := make([]byte, 16)
+		block.Encrypt(out, append(aiv, padded...))
+		return out, nil
+	}
+
+	n := len(padded) / 8
+	a := aiv
+	r := make([]byte, len(padded))
+	copy(r, padded)
+
+	b := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(b, a)
+			copy(b[8:], r[i*8:(i+1)*8])
+			block.Encrypt(b, b)
+
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[i*8:], b[8:])
+		}
+	}
+
+	return append(a, r...), nil
+}
+
+// wrapKeyForImport wraps the given key material for import into the transit
+// secrets engine. The key material is wrapped with an ephemeral AES-256 key,
+// which is in turn wrapped with the engine's RSA wrapping key using OAEP and
+// the given hash function. The result is base64 encoded as expected by the
+// import endpoint.
+func wrapKeyForImport(wrappingKeyPEM, hashFunction string, key []byte) (string, error) {
+	pubKey, err := parseWrappingKey(wrappingKeyPEM)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := oaepHash(hashFunction)
+	if err != nil {
+		return "", err
+	}
+
+	ephemeralKey := make([]byte, 32)
+	if _, err := rand.Read(ephemeralKey); err != nil {
+		return "", fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+
+	wrappedEphemeralKey, err := rsa.EncryptOAEP(hash.New(), rand.Reader, pubKey, ephemeralKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap ephemeral key: %w", err)
+	}
+
+	wrappedKey, err := wrapKeyWithPadding(ephemeralKey, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap key material: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrappedEphemeralKey, wrappedKey...)), nil
+}
+
+func parseWrappingKey(wrappingKeyPEM string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(wrappingKeyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode wrapping key PEM")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse wrapping key: %w", err)
+	}
+
+	pubKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an RSA wrapping key, got %T", key)
+	}
+
+	return pubKey, nil
+}
+
+func oaepHash(hashFunction string) (crypto.Hash, error) {
+	switch strings.ToUpper(hashFunction) {
+	case "SHA1":
+		return crypto.SHA1, nil
+	case "SHA224":
+		return crypto.SHA224, nil
+	case "", "SHA256":
+		return crypto.SHA256, nil
+	case "SHA384":
+		return crypto.SHA384, nil
+	case "SHA512":
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("unsupported hash function %q", hashFunction)
+}
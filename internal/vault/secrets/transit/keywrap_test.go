@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"testing"
+)
+
+func TestWrapKeyWithPadding(t *testing.T) {
+	t.Parallel()
+
+	// Test vectors from RFC 5649, section 6.
+	kek := mustDecodeHex(t, "5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8")
+
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{
+			name: "20 octet key",
+			key:  "c37b7e6492584340bed12207808941155068f738",
+			want: "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		{
+			name: "7 octet key",
+			key:  "466f7250617369",
+			want: "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := wrapKeyWithPadding(kek, mustDecodeHex(t, tt.key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("wrapKeyWithPadding() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := wrapKeyWithPadding(kek, nil); err == nil {
+		t.Error("expected an error wrapping an empty key")
+	}
+}
+
+func TestWrapKeyForImport(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrappingKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	key := bytes.Repeat([]byte{0x42}, 32)
+	ciphertext, err := wrapKeyForImport(wrappingKeyPEM, "SHA256", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ephemeralKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privKey, raw[:privKey.Size()], nil)
+	if err != nil {
+		t.Fatalf("failed to unwrap ephemeral key: %s", err)
+	}
+
+	want, err := wrapKeyWithPadding(ephemeralKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(raw[privKey.Size():], want) {
+		t.Errorf("unexpected wrapped key material")
+	}
+
+	if _, err := wrapKeyForImport(wrappingKeyPEM, "MD5", key); err == nil {
+		t.Error("expected an error for an unsupported hash function")
+	}
+	if _, err := wrapKeyForImport("invalid", "SHA256", key); err == nil {
+		t.Error("expected an error for an invalid wrapping key")
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
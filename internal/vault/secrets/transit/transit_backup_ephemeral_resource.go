@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ ephemeral.EphemeralResource = &TransitBackupEphemeralResource{}
+
+var NewTransitBackupEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitBackupEphemeralResource{}
+}
+
+// TransitBackupEphemeralResource exports a plaintext backup of a transit key.
+type TransitBackupEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+type TransitBackupModel struct {
+	base.BaseModelEphemeral
+
+	Mount types.String `tfsdk:"mount"`
+	Name  types.String `tfsdk:"name"`
+
+	// Computed
+	Backup types.String `tfsdk:"backup"`
+}
+
+func (r *TransitBackupEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the transit key to back up. The key must have `exportable` and " +
+					"`allow_plaintext_backup` set.",
+				Required: true,
+			},
+			consts.FieldBackup: schema.StringAttribute{
+				MarkdownDescription: "The backed up key data, which can be restored with the " +
+					"`vault_transit_secret_backend_key_import` resource.",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+		MarkdownDescription: "Exports a plaintext backup of a transit key.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitBackupEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_backup"
+}
+
+func (r *TransitBackupEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitBackupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/backup/%s", data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Backing up transit key", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	backup, ok := secret.Data[consts.FieldBackup].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected API response",
+			fmt.Sprintf("expected string for %q, got %T", consts.FieldBackup, secret.Data[consts.FieldBackup]),
+		)
+		return
+	}
+	data.Backup = types.StringValue(backup)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+const (
+	dataKeyTypePlaintext = "plaintext"
+	dataKeyTypeWrapped   = "wrapped"
+)
+
+var _ ephemeral.EphemeralResource = &TransitDataKeyEphemeralResource{}
+
+var NewTransitDataKeyEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitDataKeyEphemeralResource{}
+}
+
+// TransitDataKeyEphemeralResource generates a new high-entropy data key
+// wrapped by a transit key, for use in envelope encryption.
+type TransitDataKeyEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+type TransitDataKeyModel struct {
+	base.BaseModelEphemeral
+
+	Mount   types.String `tfsdk:"mount"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Context types.String `tfsdk:"context"`
+	Nonce   types.String `tfsdk:"nonce"`
+	Bits    types.Int64  `tfsdk:"bits"`
+
+	// Computed
+	Plaintext  types.String `tfsdk:"plaintext"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	KeyVersion types.Int64  `tfsdk:"key_version"`
+}
+
+type transitDataKeyAPIModel struct {
+	Plaintext  string `json:"plaintext"`
+	Ciphertext string `json:"ciphertext"`
+	KeyVersion int64  `json:"key_version"`
+}
+
+func (r *TransitDataKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the transit key to encrypt the data key with.",
+				Required:            true,
+			},
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Type of data key to generate. If `plaintext`, the plaintext key is returned " +
+					"along with the ciphertext. If `wrapped`, only the ciphertext is returned.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataKeyTypePlaintext, dataKeyTypeWrapped),
+				},
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded context for key derivation. Required if key derivation is enabled.",
+				Optional:            true,
+			},
+			consts.FieldNonce: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded nonce value. Only used for convergent encryption keys created before Vault 0.6.2.",
+				Optional:            true,
+			},
+			consts.FieldBits: schema.Int64Attribute{
+				MarkdownDescription: "Number of bits in the generated key. One of `128`, `256` or `512`. Defaults to `256`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(128, 256, 512),
+				},
+			},
+			consts.FieldPlaintext: schema.StringAttribute{
+				MarkdownDescription: "The base64 encoded plaintext data key. Only set when `type` is `plaintext`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldCiphertext: schema.StringAttribute{
+				MarkdownDescription: "The data key encrypted with the transit key.",
+				Computed:            true,
+			},
+			consts.FieldKeyVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version of the transit key used to encrypt the data key.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Generates a new data key wrapped by a transit key, for use in envelope encryption.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitDataKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_datakey"
+}
+
+func (r *TransitDataKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitDataKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	requestData := map[string]interface{}{}
+	if !data.Context.IsNull() {
+		requestData[consts.FieldContext] = data.Context.ValueString()
+	}
+	if !data.Nonce.IsNull() {
+		requestData[consts.FieldNonce] = data.Nonce.ValueString()
+	}
+	if !data.Bits.IsNull() {
+		requestData[consts.FieldBits] = data.Bits.ValueInt64()
+	}
+
+	path := fmt.Sprintf("%s/datakey/%s/%s", data.Mount.ValueString(), data.Type.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Generating transit data key", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var apiModel transitDataKeyAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.Plaintext = types.StringNull()
+	if apiModel.Plaintext != "" {
+		data.Plaintext = types.StringValue(apiModel.Plaintext)
+	}
+	data.Ciphertext = types.StringValue(apiModel.Ciphertext)
+	data.KeyVersion = types.Int64Value(apiModel.KeyVersion)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccTransitDataKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitDataKeyConfig(backend, "plaintext", 512),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"), knownvalue.StringRegexp(testutil.RegexpBase64)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"), knownvalue.StringRegexp(regexpTransitCiphertext)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: testAccTransitDataKeyConfig(backend, "wrapped", 256),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"), knownvalue.StringRegexp(regexpTransitCiphertext)),
+				},
+			},
+			{
+				Config:      testAccTransitDataKeyConfig(backend, "plaintext", 64),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccTransitDataKeyConfig(backend, keyType string, bits int) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "vault_transit_datakey" "test" {
+  mount_id = vault_transit_secret_backend_key.test.id
+  mount    = vault_mount.transit.path
+  name     = vault_transit_secret_backend_key.test.name
+  type     = "%s"
+  bits     = %d
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_datakey.test
+}
+
+resource "echo" "test" {}
+`, testAccTransitKeyConfig(backend), keyType, bits)
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+var _ ephemeral.EphemeralResource = &TransitHMACEphemeralResource{}
+
+var NewTransitHMACEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitHMACEphemeralResource{}
+}
+
+// TransitHMACEphemeralResource generates the HMAC of the given input using a
+// transit key.
+type TransitHMACEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+type TransitHMACModel struct {
+	base.BaseModelEphemeral
+
+	Mount      types.String `tfsdk:"mount"`
+	Name       types.String `tfsdk:"name"`
+	Input      types.String `tfsdk:"input"`
+	KeyVersion types.Int64  `tfsdk:"key_version"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	BatchInput types.List   `tfsdk:"batch_input"`
+
+	// Computed
+	HMAC         types.String `tfsdk:"hmac"`
+	BatchResults types.List   `tfsdk:"batch_results"`
+}
+
+type transitHMACAPIModel struct {
+	HMAC string `json:"hmac"`
+}
+
+func (r *TransitHMACEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the transit key to generate the HMAC with.",
+				Required:            true,
+			},
+			consts.FieldInput: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded input data. One of `input` or `batch_input` must be supplied.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			consts.FieldKeyVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version of the key to use. Defaults to the latest version.",
+				Optional:            true,
+			},
+			consts.FieldAlgorithm: schema.StringAttribute{
+				MarkdownDescription: "Hash algorithm to use. Defaults to `sha2-256`.",
+				Optional:            true,
+			},
+			consts.FieldBatchInput: batchInputAttribute(
+				"List of items to process, each with an `input` and optionally a `reference`.",
+			),
+			consts.FieldHMAC: schema.StringAttribute{
+				MarkdownDescription: "The generated HMAC.",
+				Computed:            true,
+			},
+			consts.FieldBatchResults: batchResultsAttribute(),
+		},
+		MarkdownDescription: "Generates the HMAC of the given input data using a transit key.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitHMACEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_hmac"
+}
+
+func (r *TransitHMACEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitHMACModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Input.IsNull() && data.BatchInput.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing required argument",
+			fmt.Sprintf("One of %q or %q must be supplied", consts.FieldInput, consts.FieldBatchInput),
+		)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	requestData := map[string]interface{}{}
+	if !data.BatchInput.IsNull() {
+		input, diags := batchInput(ctx, data.BatchInput, []string{consts.FieldKeyVersion})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestData[consts.FieldBatchInput] = input
+	} else {
+		requestData[consts.FieldInput] = data.Input.ValueString()
+	}
+
+	if !data.KeyVersion.IsNull() {
+		requestData[consts.FieldKeyVersion] = data.KeyVersion.ValueInt64()
+	}
+	if !data.Algorithm.IsNull() {
+		requestData[consts.FieldAlgorithm] = data.Algorithm.ValueString()
+	}
+
+	path := fmt.Sprintf("%s/hmac/%s", data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Generating HMAC with transit key", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	data.HMAC = types.StringNull()
+	data.BatchResults = types.ListNull(batchElemType)
+
+	if raw, ok := secret.Data[consts.FieldBatchResults]; ok {
+		results, diags := batchResults(ctx, raw)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.BatchResults = results
+	} else {
+		var apiModel transitHMACAPIModel
+		if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+			resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+			return
+		}
+		data.HMAC = types.StringValue(apiModel.HMAC)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+var regexpTransitHMAC = regexp.MustCompile(`^vault:v1:`)
+
+func TestAccTransitHMAC(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitHMACConfig(backend, `
+  input     = base64encode("foo")
+  algorithm = "sha2-512"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("hmac"), knownvalue.StringRegexp(regexpTransitHMAC)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccTransitHMACConfig(backend, `
+  batch_input = [
+    {
+      input     = base64encode("foo")
+      reference = "foo"
+    },
+    {
+      input     = base64encode("bar")
+      reference = "bar"
+    },
+  ]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("hmac"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(1).AtMapKey("hmac"), knownvalue.StringRegexp(regexpTransitHMAC)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(1).AtMapKey("reference"), knownvalue.StringExact("bar")),
+				},
+			},
+			{
+				Config:      testAccTransitHMACConfig(backend, ""),
+				ExpectError: regexp.MustCompile(`One of "input" or "batch_input" must be supplied`),
+			},
+		},
+	})
+}
+
+func testAccTransitHMACConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "vault_transit_hmac" "test" {
+  mount_id = vault_transit_secret_backend_key.test.id
+  mount    = vault_mount.transit.path
+  name     = vault_transit_secret_backend_key.test.name
+%s
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_hmac.test
+}
+
+resource "echo" "test" {}
+`, testAccTransitKeyConfig(backend), extra)
+}
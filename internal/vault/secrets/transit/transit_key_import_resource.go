@@ -0,0 +1,519 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var keyImportIDRe = regexp.MustCompile(`^(.+)/keys/([^/]+)$`)
+
+var (
+	_ resource.ResourceWithConfigure   = &TransitKeyImportResource{}
+	_ resource.ResourceWithImportState = &TransitKeyImportResource{}
+)
+
+// NewTransitKeyImportResource returns the implementation for this resource
+func NewTransitKeyImportResource() resource.Resource {
+	return &TransitKeyImportResource{}
+}
+
+// TransitKeyImportResource brings existing key material into the transit
+// secrets engine, either by restoring a backup or by importing an externally
+// generated key (BYOK).
+type TransitKeyImportResource struct {
+	base.ResourceWithConfigure
+}
+
+// TransitKeyImportModel describes the Terraform resource data model
+type TransitKeyImportModel struct {
+	base.BaseModel
+
+	Mount                types.String `tfsdk:"mount"`
+	Name                 types.String `tfsdk:"name"`
+	BackupWO             types.String `tfsdk:"backup_wo"`
+	CiphertextWO         types.String `tfsdk:"ciphertext_wo"`
+	KeyMaterialWO        types.String `tfsdk:"key_material_wo"`
+	ImportVersion        types.Int64  `tfsdk:"import_version"`
+	Force                types.Bool   `tfsdk:"force"`
+	Type                 types.String `tfsdk:"type"`
+	HashFunction         types.String `tfsdk:"hash_function"`
+	Context              types.String `tfsdk:"context"`
+	Derived              types.Bool   `tfsdk:"derived"`
+	Exportable           types.Bool   `tfsdk:"exportable"`
+	AllowPlaintextBackup types.Bool   `tfsdk:"allow_plaintext_backup"`
+	AllowRotation        types.Bool   `tfsdk:"allow_rotation"`
+	DeletionAllowed      types.Bool   `tfsdk:"deletion_allowed"`
+	LatestVersion        types.Int64  `tfsdk:"latest_version"`
+}
+
+// transitKeyAPIModel describes the Vault API response for a transit key
+type transitKeyAPIModel struct {
+	Type                 string `json:"type"`
+	Derived              bool   `json:"derived"`
+	Exportable           bool   `json:"exportable"`
+	AllowPlaintextBackup bool   `json:"allow_plaintext_backup"`
+	DeletionAllowed      bool   `json:"deletion_allowed"`
+	LatestVersion        int64  `json:"latest_version"`
+}
+
+func (r *TransitKeyImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_secret_backend_key_import"
+}
+
+func (r *TransitKeyImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sources := path.Expressions{
+		path.MatchRoot(consts.FieldBackupWO),
+		path.MatchRoot(consts.FieldCiphertextWO),
+		path.MatchRoot(consts.FieldKeyMaterialWO),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the transit secrets engine is mounted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the transit key to create.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldBackupWO: schema.StringAttribute{
+				MarkdownDescription: "Backed up key data to restore, as returned by the `vault_transit_backup` " +
+					"ephemeral resource. Exactly one of `backup_wo`, `ciphertext_wo` or `key_material_wo` must be supplied.",
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(sources...),
+				},
+			},
+			consts.FieldCiphertextWO: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded key material, already wrapped with the engine's wrapping key. " +
+					"Exactly one of `backup_wo`, `ciphertext_wo` or `key_material_wo` must be supplied.",
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			consts.FieldKeyMaterialWO: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded key material to import. The provider wraps it with the engine's " +
+					"wrapping key before sending it to Vault. Exactly one of `backup_wo`, `ciphertext_wo` or " +
+					"`key_material_wo` must be supplied.",
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			consts.FieldImportVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version of the imported key material. Changing this value replaces the key " +
+					"with the currently configured key material.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldForce: schema.BoolAttribute{
+				MarkdownDescription: "If set, restoring a backup overwrites an existing key with the same name. " +
+					"Only used with `backup_wo`.",
+				Optional: true,
+			},
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Type of the imported key. Defaults to `aes256-gcm96`. Not used with `backup_wo`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldHashFunction: schema.StringAttribute{
+				MarkdownDescription: "Hash function used for the RSA-OAEP step of wrapping the key material. " +
+					"One of `SHA1`, `SHA224`, `SHA256`, `SHA384` or `SHA512`. Defaults to `SHA256`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SHA1", "SHA224", "SHA256", "SHA384", "SHA512"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded context for key derivation. Required if `derived` is set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldDerived: schema.BoolAttribute{
+				MarkdownDescription: "Specifies if key derivation is to be used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldExportable: schema.BoolAttribute{
+				MarkdownDescription: "Enables keys to be exportable.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldAllowPlaintextBackup: schema.BoolAttribute{
+				MarkdownDescription: "Enables taking a backup of the named key in plaintext format.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldAllowRotation: schema.BoolAttribute{
+				MarkdownDescription: "If set, the imported key can be rotated within Vault.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldDeletionAllowed: schema.BoolAttribute{
+				MarkdownDescription: "Specifies if the key is allowed to be deleted. Must be `true` for " +
+					"Terraform to delete the key.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.FieldLatestVersion: schema.Int64Attribute{
+				MarkdownDescription: "Latest version of the key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Restores a transit key from a backup, or imports externally generated key material " +
+			"into the transit secrets engine.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+func (r *TransitKeyImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config TransitKeyImportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mount := plan.Mount.ValueString()
+	name := plan.Name.ValueString()
+
+	if !config.BackupWO.IsNull() {
+		restorePath := fmt.Sprintf("%s/restore/%s", mount, name)
+		data := map[string]interface{}{
+			consts.FieldBackup: config.BackupWO.ValueString(),
+		}
+		if !plan.Force.IsNull() {
+			data[consts.FieldForce] = plan.Force.ValueBool()
+		}
+
+		tflog.Debug(ctx, "Restoring transit key", map[string]any{consts.FieldPath: restorePath})
+		if _, err := cli.Logical().WriteWithContext(ctx, restorePath, data); err != nil {
+			resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+			return
+		}
+	} else {
+		ciphertext := config.CiphertextWO.ValueString()
+		if !config.KeyMaterialWO.IsNull() {
+			var diags diag.Diagnostics
+			ciphertext, diags = r.wrapKeyMaterial(ctx, cli, mount, plan.HashFunction.ValueString(), config.KeyMaterialWO.ValueString())
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		importPath := fmt.Sprintf("%s/keys/%s/import", mount, name)
+		data := importRequest(&plan)
+		data[consts.FieldCiphertext] = ciphertext
+
+		tflog.Debug(ctx, "Importing transit key", map[string]any{consts.FieldPath: importPath})
+		if _, err := cli.Logical().WriteWithContext(ctx, importPath, data); err != nil {
+			resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+			return
+		}
+	}
+
+	if !plan.DeletionAllowed.IsNull() && !plan.DeletionAllowed.IsUnknown() {
+		resp.Diagnostics.Append(r.writeConfig(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TransitKeyImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TransitKeyImportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Name.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TransitKeyImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TransitKeyImportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DeletionAllowed.IsUnknown() {
+		resp.Diagnostics.Append(r.writeConfig(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TransitKeyImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TransitKeyImportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	keyPath := transitKeyPath(state.Mount.ValueString(), state.Name.ValueString())
+
+	tflog.Debug(ctx, "Deleting transit key", map[string]any{consts.FieldPath: keyPath})
+	if _, err := cli.Logical().DeleteWithContext(ctx, keyPath); err != nil && !util.Is404(err) {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+func (r *TransitKeyImportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	matches := keyImportIDRe.FindStringSubmatch(req.ID)
+	if len(matches) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be in the format '<mount>/keys/<name>', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldMount), matches[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldName), matches[2])...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+
+	base.ImportClusterFromEnv(ctx, resp)
+}
+
+// read refreshes the model from Vault. The name is set to null if the key no
+// longer exists.
+func (r *TransitKeyImportResource) read(ctx context.Context, data *TransitKeyImportModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	keyPath := transitKeyPath(data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Reading transit key", map[string]any{consts.FieldPath: keyPath})
+	secret, err := cli.Logical().ReadWithContext(ctx, keyPath)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		return diags
+	}
+	if secret == nil {
+		tflog.Warn(ctx, "Transit key not found, removing from state", map[string]any{consts.FieldPath: keyPath})
+		data.Name = types.StringNull()
+		return diags
+	}
+
+	var apiModel transitKeyAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+		diags.AddError("Unable to translate Vault response data", err.Error())
+		return diags
+	}
+
+	data.Type = types.StringValue(apiModel.Type)
+	data.Derived = types.BoolValue(apiModel.Derived)
+	data.Exportable = types.BoolValue(apiModel.Exportable)
+	data.AllowPlaintextBackup = types.BoolValue(apiModel.AllowPlaintextBackup)
+	data.DeletionAllowed = types.BoolValue(apiModel.DeletionAllowed)
+	data.LatestVersion = types.Int64Value(apiModel.LatestVersion)
+
+	return diags
+}
+
+func (r *TransitKeyImportResource) writeConfig(ctx context.Context, data *TransitKeyImportModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	configPath := transitKeyPath(data.Mount.ValueString(), data.Name.ValueString()) + "/config"
+	configData := map[string]interface{}{
+		consts.FieldDeletionAllowed: data.DeletionAllowed.ValueBool(),
+	}
+
+	tflog.Debug(ctx, "Writing transit key config", map[string]any{consts.FieldPath: configPath})
+	if _, err := cli.Logical().WriteWithContext(ctx, configPath, configData); err != nil {
+		diags.AddError(errutil.VaultUpdateErr(err))
+	}
+
+	return diags
+}
+
+// wrapKeyMaterial fetches the engine's wrapping key and uses it to wrap the
+// base64 encoded key material for import.
+func (r *TransitKeyImportResource) wrapKeyMaterial(ctx context.Context, cli *api.Client, mount, hashFunction, keyMaterial string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	key, err := base64.StdEncoding.DecodeString(keyMaterial)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid %s", consts.FieldKeyMaterialWO), fmt.Sprintf("Key material must be base64 encoded: %s", err))
+		return "", diags
+	}
+
+	wrappingKeyPath := fmt.Sprintf("%s/wrapping_key", mount)
+	tflog.Debug(ctx, "Reading transit wrapping key", map[string]any{consts.FieldPath: wrappingKeyPath})
+	secret, err := cli.Logical().ReadWithContext(ctx, wrappingKeyPath)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		return "", diags
+	}
+	if secret == nil {
+		diags.AddError(errutil.VaultReadResponseNil())
+		return "", diags
+	}
+
+	wrappingKey, ok := secret.Data[consts.FieldPublicKey].(string)
+	if !ok {
+		diags.AddError(
+			"Unexpected API response",
+			fmt.Sprintf("expected string for %q, got %T", consts.FieldPublicKey, secret.Data[consts.FieldPublicKey]),
+		)
+		return "", diags
+	}
+
+	ciphertext, err := wrapKeyForImport(wrappingKey, hashFunction, key)
+	if err != nil {
+		diags.AddError("Error wrapping key material", err.Error())
+		return "", diags
+	}
+
+	return ciphertext, diags
+}
+
+// importRequest returns the request body for the import endpoint, without
+// the ciphertext.
+func importRequest(data *TransitKeyImportModel) map[string]interface{} {
+	req := map[string]interface{}{}
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		req[consts.FieldType] = data.Type.ValueString()
+	}
+	if !data.HashFunction.IsNull() {
+		req[consts.FieldHashFunction] = data.HashFunction.ValueString()
+	}
+	if !data.Context.IsNull() {
+		req[consts.FieldContext] = data.Context.ValueString()
+	}
+	for field, v := range map[string]types.Bool{
+		consts.FieldDerived:              data.Derived,
+		consts.FieldExportable:           data.Exportable,
+		consts.FieldAllowPlaintextBackup: data.AllowPlaintextBackup,
+		consts.FieldAllowRotation:        data.AllowRotation,
+	} {
+		if !v.IsNull() && !v.IsUnknown() {
+			req[field] = v.ValueBool()
+		}
+	}
+	return req
+}
+
+func transitKeyPath(mount, name string) string {
+	return fmt.Sprintf("%s/keys/%s", mount, name)
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+const testTransitKeyImportResource = "vault_transit_secret_backend_key_import.test"
+
+func TestAccTransitKeyImport_keyMaterial(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	keyMaterial := base64.StdEncoding.EncodeToString(key)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitKeyImportKeyMaterialConfig(backend, keyMaterial, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldMount, backend),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldName, "imported"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldType, "aes256-gcm96"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldExportable, "false"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldDeletionAllowed, "false"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldLatestVersion, "1"),
+					resource.TestCheckNoResourceAttr(testTransitKeyImportResource, consts.FieldKeyMaterialWO),
+				),
+			},
+			{
+				Config: testAccTransitKeyImportKeyMaterialConfig(backend, keyMaterial, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldDeletionAllowed, "true"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldLatestVersion, "1"),
+				),
+			},
+			{
+				ResourceName:                         testTransitKeyImportResource,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s/keys/imported", backend),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: consts.FieldName,
+				ImportStateVerifyIgnore: []string{
+					consts.FieldImportVersion,
+					consts.FieldAllowRotation,
+				},
+			},
+		},
+	})
+}
+
+func TestAccTransitKeyImport_restore(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitKeyImportRestoreConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldName, "restored"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldType, "aes256-gcm96"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldExportable, "true"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldAllowPlaintextBackup, "true"),
+					resource.TestCheckResourceAttr(testTransitKeyImportResource, consts.FieldDeletionAllowed, "true"),
+					resource.TestCheckNoResourceAttr(testTransitKeyImportResource, consts.FieldBackupWO),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTransitKeyImport_invalid(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  mount = vault_mount.transit.path
+  name  = "imported"
+}
+`, backend),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccTransitKeyImportKeyMaterialConfig(backend, keyMaterial string, deletionAllowed bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  mount            = vault_mount.transit.path
+  name             = "imported"
+  key_material_wo  = "%s"
+  import_version   = 1
+  deletion_allowed = %t
+}
+`, backend, keyMaterial, deletionAllowed)
+}
+
+func testAccTransitKeyImportRestoreConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "source" {
+  backend                = vault_mount.transit.path
+  name                   = "source"
+  exportable             = true
+  allow_plaintext_backup = true
+  deletion_allowed       = true
+}
+
+ephemeral "vault_transit_backup" "source" {
+  mount_id = vault_transit_secret_backend_key.source.id
+  mount    = vault_mount.transit.path
+  name     = vault_transit_secret_backend_key.source.name
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  mount            = vault_mount.transit.path
+  name             = "restored"
+  backup_wo        = ephemeral.vault_transit_backup.source.backup
+  import_version   = 1
+  deletion_allowed = true
+}
+`, backend)
+}
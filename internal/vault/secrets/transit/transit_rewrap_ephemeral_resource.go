@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+var _ ephemeral.EphemeralResource = &TransitRewrapEphemeralResource{}
+
+var NewTransitRewrapEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitRewrapEphemeralResource{}
+}
+
+// TransitRewrapEphemeralResource rewraps ciphertext with the latest (or a
+// given) version of a transit key, without exposing the plaintext.
+type TransitRewrapEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+type TransitRewrapModel struct {
+	base.BaseModelEphemeral
+
+	Mount      types.String `tfsdk:"mount"`
+	Name       types.String `tfsdk:"name"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	Context    types.String `tfsdk:"context"`
+	Nonce      types.String `tfsdk:"nonce"`
+	KeyVersion types.Int64  `tfsdk:"key_version"`
+	BatchInput types.List   `tfsdk:"batch_input"`
+
+	// Computed
+	NewCiphertext types.String `tfsdk:"new_ciphertext"`
+	BatchResults  types.List   `tfsdk:"batch_results"`
+}
+
+type transitRewrapAPIModel struct {
+	Ciphertext string `json:"ciphertext"`
+	KeyVersion int64  `json:"key_version"`
+}
+
+func (r *TransitRewrapEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the transit key to rewrap the ciphertext with.",
+				Required:            true,
+			},
+			consts.FieldCiphertext: schema.StringAttribute{
+				MarkdownDescription: "Ciphertext to rewrap. One of `ciphertext` or `batch_input` must be supplied.",
+				Optional:            true,
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded context for key derivation. Required if key derivation is enabled.",
+				Optional:            true,
+			},
+			consts.FieldNonce: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded nonce value used during encryption. Only required for convergent encryption keys created before Vault 0.6.2.",
+				Optional:            true,
+			},
+			consts.FieldKeyVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version of the key to rewrap the ciphertext with. Defaults to the latest version. " +
+					"After the operation this is set to the key version that was used.",
+				Optional: true,
+				Computed: true,
+			},
+			consts.FieldBatchInput: batchInputAttribute(
+				"List of items to rewrap, each with a `ciphertext` and optionally a `context`, `nonce` and `reference`.",
+			),
+			consts.FieldNewCiphertext: schema.StringAttribute{
+				MarkdownDescription: "The rewrapped ciphertext.",
+				Computed:            true,
+			},
+			consts.FieldBatchResults: batchResultsAttribute(),
+		},
+		MarkdownDescription: "Rewraps ciphertext with the latest (or a given) version of a transit key, without revealing the plaintext.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitRewrapEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_rewrap"
+}
+
+func (r *TransitRewrapEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitRewrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Ciphertext.IsNull() && data.BatchInput.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing required argument",
+			fmt.Sprintf("One of %q or %q must be supplied", consts.FieldCiphertext, consts.FieldBatchInput),
+		)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	requestData := map[string]interface{}{}
+	if !data.BatchInput.IsNull() {
+		input, diags := batchInput(ctx, data.BatchInput, []string{consts.FieldKeyVersion})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestData[consts.FieldBatchInput] = input
+	} else {
+		requestData[consts.FieldCiphertext] = data.Ciphertext.ValueString()
+	}
+
+	if !data.Context.IsNull() {
+		requestData[consts.FieldContext] = data.Context.ValueString()
+	}
+	if !data.Nonce.IsNull() {
+		requestData[consts.FieldNonce] = data.Nonce.ValueString()
+	}
+	if !data.KeyVersion.IsNull() && !data.KeyVersion.IsUnknown() {
+		requestData[consts.FieldKeyVersion] = data.KeyVersion.ValueInt64()
+	}
+
+	path := fmt.Sprintf("%s/rewrap/%s", data.Mount.ValueString(), data.Name.ValueString())
+
+	tflog.Debug(ctx, "Rewrapping with transit key", map[string]any{consts.FieldPath: path})
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	data.NewCiphertext = types.StringNull()
+	data.BatchResults = types.ListNull(batchElemType)
+
+	if raw, ok := secret.Data[consts.FieldBatchResults]; ok {
+		results, diags := batchResults(ctx, raw)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.BatchResults = results
+		if data.KeyVersion.IsUnknown() {
+			data.KeyVersion = types.Int64Null()
+		}
+	} else {
+		var apiModel transitRewrapAPIModel
+		if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+			resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+			return
+		}
+		data.NewCiphertext = types.StringValue(apiModel.Ciphertext)
+		data.KeyVersion = types.Int64Value(apiModel.KeyVersion)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
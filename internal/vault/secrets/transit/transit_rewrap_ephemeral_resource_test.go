@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+var regexpTransitCiphertext = regexp.MustCompile(`^vault:v\d+:`)
+
+func TestAccTransitRewrap(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitRewrapConfig(backend),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("new_ciphertext"), knownvalue.StringRegexp(regexp.MustCompile(`^vault:v2:`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_version"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTransitRewrap_batch(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitRewrapBatchConfig(backend),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("new_ciphertext"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(0).AtMapKey("ciphertext"), knownvalue.StringRegexp(regexpTransitCiphertext)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(0).AtMapKey("reference"), knownvalue.StringExact("first")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(1).AtMapKey("reference"), knownvalue.StringExact("second")),
+				},
+			},
+		},
+	})
+}
+
+func testAccTransitKeyConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend = vault_mount.transit.path
+  name    = "test"
+}
+`, backend)
+}
+
+func testAccTransitRewrapConfig(backend string) string {
+	return fmt.Sprintf(`
+%s
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.transit.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+}
+
+resource "vault_generic_endpoint" "rotate" {
+  path           = "${vault_mount.transit.path}/keys/${vault_transit_secret_backend_key.test.name}/rotate"
+  disable_read   = true
+  disable_delete = true
+  data_json      = "{}"
+
+  depends_on = [data.vault_transit_encrypt.test]
+}
+
+ephemeral "vault_transit_rewrap" "test" {
+  mount_id   = vault_generic_endpoint.rotate.id
+  mount      = vault_mount.transit.path
+  name       = vault_transit_secret_backend_key.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_rewrap.test
+}
+
+resource "echo" "test" {}
+`, testAccTransitKeyConfig(backend))
+}
+
+func testAccTransitRewrapBatchConfig(backend string) string {
+	return fmt.Sprintf(`
+%s
+
+data "vault_transit_encrypt" "first" {
+  backend   = vault_mount.transit.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+}
+
+data "vault_transit_encrypt" "second" {
+  backend   = vault_mount.transit.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "bar"
+}
+
+ephemeral "vault_transit_rewrap" "test" {
+  mount_id = vault_mount.transit.id
+  mount    = vault_mount.transit.path
+  name     = vault_transit_secret_backend_key.test.name
+  batch_input = [
+    {
+      ciphertext = data.vault_transit_encrypt.first.ciphertext
+      reference  = "first"
+    },
+    {
+      ciphertext = data.vault_transit_encrypt.second.ciphertext
+      reference  = "second"
+    },
+  ]
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_rewrap.test
+}
+
+resource "echo" "test" {}
+`, testAccTransitKeyConfig(backend))
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &TransitWrappingKeyDataSource{}
+
+// NewTransitWrappingKeyDataSource returns the implementation for this data source
+func NewTransitWrappingKeyDataSource() datasource.DataSource {
+	return &TransitWrappingKeyDataSource{}
+}
+
+// TransitWrappingKeyDataSource implements the methods that define this data source
+type TransitWrappingKeyDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// TransitWrappingKeyModel describes the Terraform data source data model
+type TransitWrappingKeyModel struct {
+	base.BaseModel
+
+	Mount     types.String `tfsdk:"mount"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+func (d *TransitWrappingKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_wrapping_key"
+}
+
+func (d *TransitWrappingKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldPublicKey: schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded RSA public key used to wrap key material for import.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Reads the wrapping key of a transit secrets engine, used to wrap key material for import.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *TransitWrappingKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TransitWrappingKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/wrapping_key", data.Mount.ValueString())
+
+	tflog.Debug(ctx, "Reading transit wrapping key", map[string]any{consts.FieldPath: path})
+	secret, err := cli.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	publicKey, ok := secret.Data[consts.FieldPublicKey].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected API response",
+			fmt.Sprintf("expected string for %q, got %T", consts.FieldPublicKey, secret.Data[consts.FieldPublicKey]),
+		)
+		return
+	}
+	data.PublicKey = types.StringValue(publicKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -76,7 +76,7 @@ func (d *ActivationFlagsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	cli, err := client.GetClient(ctx, d.Meta(), "")
+	cli, err := client.GetClient(ctx, d.Meta(), "", "")
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
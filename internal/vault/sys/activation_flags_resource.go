@@ -105,7 +105,7 @@ func (r *ActivationFlagsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), "")
+	cli, err := client.GetClient(ctx, r.Meta(), "", "")
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -142,7 +142,7 @@ func (r *ActivationFlagsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	cli, err := client.GetClient(ctx, r.Meta(), "")
+	cli, err := client.GetClient(ctx, r.Meta(), "", "")
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -179,7 +179,7 @@ func (r *AgentRegistrationResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -271,7 +271,7 @@ func (r *AgentRegistrationResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -310,7 +310,7 @@ func (r *AgentRegistrationResource) Update(ctx context.Context, req resource.Upd
 	data.CreationTime = state.CreationTime
 	data.LastUpdatedTime = state.LastUpdatedTime
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -384,7 +384,7 @@ func (r *AgentRegistrationResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -426,7 +426,9 @@ func (r *AgentRegistrationResource) ImportState(ctx context.Context, req resourc
 		data.Namespace = types.StringValue(ns)
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	base.ImportClusterFromEnv(ctx, resp)
+
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// builtinAuthTypes are the auth methods that are mounted by Vault itself
+// and cannot be managed with vault_auth_backend.
+var builtinAuthTypes = []string{
+	"token",
+	"ns_token",
+}
+
+// Ensure the implementation satisfies the list.ListResource interface
+var _ list.ListResourceWithRawV5Schemas = &AuthBackendListResource{}
+
+// NewAuthBackendListResource returns the implementation for this list resource
+func NewAuthBackendListResource() list.ListResource {
+	return &AuthBackendListResource{}
+}
+
+// AuthBackendListResource lists the auth methods enabled in a namespace
+type AuthBackendListResource struct {
+	base.ListResourceWithSDKv2
+}
+
+func (r *AuthBackendListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_backend"
+}
+
+func (r *AuthBackendListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Only list auth methods of this type.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Lists the auth methods enabled in a namespace.",
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+func (r *AuthBackendListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data MountListModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing auth methods")
+	auths, err := cli.Sys().ListAuthWithContext(ctx)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	mounts := make(map[string]*api.MountOutput, len(auths))
+	for path, auth := range auths {
+		mounts[path] = &api.MountOutput{Type: auth.Type}
+	}
+
+	stream.Results = r.ListResults(ctx, req, data.Namespace.ValueString(), data.Cluster.ValueString(),
+		mountListResults(mounts, data.Type.ValueString(), builtinAuthTypes))
+}
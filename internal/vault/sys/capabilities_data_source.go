@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &CapabilitiesDataSource{}
+
+// NewCapabilitiesDataSource returns the implementation for this data source
+func NewCapabilitiesDataSource() datasource.DataSource {
+	return &CapabilitiesDataSource{}
+}
+
+// CapabilitiesDataSource implements the methods that define this data source
+type CapabilitiesDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// CapabilitiesModel describes the Terraform data source data model
+type CapabilitiesModel struct {
+	base.BaseModel
+
+	Paths        []types.String `tfsdk:"paths"`
+	Token        types.String   `tfsdk:"token"`
+	Accessor     types.String   `tfsdk:"accessor"`
+	Capabilities types.Map      `tfsdk:"capabilities"`
+}
+
+func (d *CapabilitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capabilities"
+}
+
+func (d *CapabilitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPaths: schema.ListAttribute{
+				MarkdownDescription: "The paths to check the capabilities on.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The token to check the capabilities of. Defaults to the token " +
+					"of the provider.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(consts.FieldAccessor)),
+				},
+			},
+			consts.FieldAccessor: schema.StringAttribute{
+				MarkdownDescription: "The accessor of the token to check the capabilities of.",
+				Optional:            true,
+			},
+			consts.FieldCapabilities: schema.MapAttribute{
+				MarkdownDescription: "The capabilities of the token on each path, e.g. `[\"read\", \"list\"]`, " +
+					"or `[\"deny\"]` if it has none.",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+		MarkdownDescription: "Reads the capabilities of a token on a list of paths, as evaluated by Vault " +
+			"from all its policies.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *CapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapabilitiesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	paths := make([]string, 0, len(data.Paths))
+	for _, p := range data.Paths {
+		paths = append(paths, p.ValueString())
+	}
+
+	capabilitiesPath := "sys/capabilities-self"
+	body := map[string]interface{}{
+		consts.FieldPaths: paths,
+	}
+	switch {
+	case data.Token.ValueString() != "":
+		capabilitiesPath = "sys/capabilities"
+		body[consts.FieldToken] = data.Token.ValueString()
+	case data.Accessor.ValueString() != "":
+		capabilitiesPath = "sys/capabilities-accessor"
+		body[consts.FieldAccessor] = data.Accessor.ValueString()
+	}
+
+	tflog.Debug(ctx, "Reading capabilities", map[string]any{
+		consts.FieldPath:  capabilitiesPath,
+		consts.FieldPaths: paths,
+	})
+	secret, err := cli.Logical().WriteWithContext(ctx, capabilitiesPath, body)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	capabilities, err := capabilitiesFromResponse(secret.Data, paths)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	m, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, capabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Capabilities = m
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// capabilitiesFromResponse returns the capabilities of each of the paths from
+// the response data, which is keyed by path.
+func capabilitiesFromResponse(data map[string]interface{}, paths []string) (map[string][]string, error) {
+	capabilities := make(map[string][]string, len(paths))
+	for _, p := range paths {
+		raw, ok := data[p].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("no capabilities returned for path %q", p)
+		}
+
+		capabilities[p] = make([]string, 0, len(raw))
+		for _, v := range raw {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected capability %v for path %q", v, p)
+			}
+			capabilities[p] = append(capabilities[p], s)
+		}
+	}
+
+	return capabilities, nil
+}
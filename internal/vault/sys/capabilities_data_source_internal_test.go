@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCapabilitiesFromResponse(t *testing.T) {
+	data := map[string]interface{}{
+		"capabilities":    []interface{}{"deny"},
+		"secret/dev/app":  []interface{}{"list", "read"},
+		"secret/prod/app": []interface{}{"deny"},
+	}
+
+	got, err := capabilitiesFromResponse(data, []string{"secret/dev/app", "secret/prod/app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string][]string{
+		"secret/dev/app":  {"list", "read"},
+		"secret/prod/app": {"deny"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("capabilitiesFromResponse() = %v, want %v", got, want)
+	}
+
+	if _, err := capabilitiesFromResponse(data, []string{"secret/other"}); err == nil {
+		t.Error("expected an error for a path missing from the response")
+	}
+}
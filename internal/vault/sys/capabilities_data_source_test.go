@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccCapabilitiesDataSource(t *testing.T) {
+	policy := acctest.RandomWithPrefix("tf-test-policy")
+	tokenDataSource := "data.vault_capabilities.token"
+	accessorDataSource := "data.vault_capabilities.accessor"
+	selfDataSource := "data.vault_capabilities.self"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilitiesDataSourceConfig(policy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tokenDataSource, "capabilities.%", "2"),
+					resource.TestCheckResourceAttr(tokenDataSource, "capabilities.secret/dev/app.#", "2"),
+					resource.TestCheckTypeSetElemAttr(tokenDataSource, "capabilities.secret/dev/app.*", "read"),
+					resource.TestCheckTypeSetElemAttr(tokenDataSource, "capabilities.secret/dev/app.*", "list"),
+					resource.TestCheckResourceAttr(tokenDataSource, "capabilities.secret/prod/app.#", "1"),
+					resource.TestCheckResourceAttr(tokenDataSource, "capabilities.secret/prod/app.0", "deny"),
+					resource.TestCheckResourceAttr(accessorDataSource, "capabilities.secret/dev/app.#", "2"),
+					resource.TestCheckResourceAttr(accessorDataSource, "capabilities.secret/prod/app.0", "deny"),
+					resource.TestCheckResourceAttr(selfDataSource, "capabilities.secret/prod/app.0", "root"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapabilitiesDataSourceConfig(policy string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+path "secret/dev/*" {
+  capabilities = ["read", "list"]
+}
+EOT
+}
+
+resource "vault_token" "test" {
+  policies = [vault_policy.test.name]
+  ttl      = "10m"
+}
+
+data "vault_capabilities" "token" {
+  token = vault_token.test.client_token
+  paths = ["secret/dev/app", "secret/prod/app"]
+}
+
+data "vault_capabilities" "accessor" {
+  accessor = vault_token.test.accessor
+  paths    = ["secret/dev/app", "secret/prod/app"]
+}
+
+data "vault_capabilities" "self" {
+  paths = ["secret/prod/app"]
+}
+`, policy)
+}
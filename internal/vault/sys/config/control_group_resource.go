@@ -118,7 +118,7 @@ func (r *ControlGroupConfigResource) readFromVault(
 	data *ControlGroupConfigModel,
 	diagnostics *diag.Diagnostics,
 ) bool {
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return false
@@ -162,7 +162,7 @@ func (r *ControlGroupConfigResource) writeAndRefresh(
 	errorFunc func(error) (string, string),
 	diagnostics *diag.Diagnostics,
 ) *ControlGroupConfigModel {
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return nil
@@ -247,7 +247,7 @@ func (r *ControlGroupConfigResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -285,6 +285,8 @@ func (r *ControlGroupConfigResource) ImportState(ctx context.Context, request re
 			response.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...,
 		)
 	}
+
+	base.ImportClusterFromEnv(ctx, response)
 }
 
 // toWriteRequest converts the Terraform model to a Vault API request payload.
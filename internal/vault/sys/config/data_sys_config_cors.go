@@ -82,7 +82,7 @@ func (d *SysConfigCORSDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	client, err := client.GetClient(ctx, d.Meta(), "")
+	client, err := client.GetClient(ctx, d.Meta(), "", "")
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -93,9 +93,9 @@ func (r *ConfigGroupPolicyApplicationResource) Schema(ctx context.Context, req r
 	base.MustAddBaseSchema(&resp.Schema)
 }
 
-// getClientForNamespace initializes and returns a Vault client for the specified namespace
-func (r *ConfigGroupPolicyApplicationResource) getClientForNamespace(ctx context.Context, namespace string, diagnostics *diag.Diagnostics) (*api.Client, bool) {
-	vaultClient, err := client.GetClient(ctx, r.Meta(), namespace)
+// getClientForNamespace initializes and returns a Vault client for the specified namespace and cluster
+func (r *ConfigGroupPolicyApplicationResource) getClientForNamespace(ctx context.Context, namespace, cluster string, diagnostics *diag.Diagnostics) (*api.Client, bool) {
+	vaultClient, err := client.GetClient(ctx, r.Meta(), namespace, cluster)
 	if err != nil {
 		diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return nil, false
@@ -134,7 +134,7 @@ func (r *ConfigGroupPolicyApplicationResource) Create(ctx context.Context, req r
 	namespace := data.Namespace.ValueString()
 
 	// Get Vault client
-	vaultClient, ok := r.getClientForNamespace(ctx, namespace, &resp.Diagnostics)
+	vaultClient, ok := r.getClientForNamespace(ctx, namespace, data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -169,7 +169,7 @@ func (r *ConfigGroupPolicyApplicationResource) Read(ctx context.Context, req res
 	namespace := data.Namespace.ValueString()
 
 	// Get Vault client
-	vaultClient, ok := r.getClientForNamespace(ctx, namespace, &resp.Diagnostics)
+	vaultClient, ok := r.getClientForNamespace(ctx, namespace, data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -226,7 +226,7 @@ func (r *ConfigGroupPolicyApplicationResource) Update(ctx context.Context, req r
 	namespace := data.Namespace.ValueString()
 
 	// Get Vault client
-	vaultClient, ok := r.getClientForNamespace(ctx, namespace, &resp.Diagnostics)
+	vaultClient, ok := r.getClientForNamespace(ctx, namespace, data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -259,7 +259,7 @@ func (r *ConfigGroupPolicyApplicationResource) Delete(ctx context.Context, req r
 	namespace := data.Namespace.ValueString()
 
 	// Get Vault client
-	vaultClient, ok := r.getClientForNamespace(ctx, namespace, &resp.Diagnostics)
+	vaultClient, ok := r.getClientForNamespace(ctx, namespace, data.Cluster.ValueString(), &resp.Diagnostics)
 	if !ok {
 		return
 	}
@@ -305,4 +305,6 @@ func (r *ConfigGroupPolicyApplicationResource) ImportState(ctx context.Context,
 		)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
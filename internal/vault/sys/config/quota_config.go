@@ -109,7 +109,7 @@ func (r *QuotaConfigResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -158,7 +158,7 @@ func (r *QuotaConfigResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -199,7 +199,7 @@ func (r *QuotaConfigResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -234,10 +234,12 @@ func (r *QuotaConfigResource) ImportState(ctx context.Context, req resource.Impo
 		)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (r *QuotaConfigResource) readState(ctx context.Context, data *QuotaConfigModel) diag.Diagnostics {
-	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	vaultClient, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		summary, detail := errutil.ClientConfigureErr(err)
 		return diag.Diagnostics{diag.NewErrorDiagnostic(summary, detail)}
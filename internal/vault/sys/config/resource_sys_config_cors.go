@@ -210,7 +210,7 @@ func (r *SysConfigCORSResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), "")
+	client, err := client.GetClient(ctx, r.Meta(), "", "")
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -239,7 +239,7 @@ func (r *SysConfigCORSResource) path() string {
 // writeConfigToVault is a helper function that writes the CORS configuration to Vault.
 // This is used by both Create and Update operations to avoid code duplication.
 func (r *SysConfigCORSResource) writeConfigToVault(ctx context.Context, data *SysConfigCORSModel, diags *diag.Diagnostics) error {
-	client, err := client.GetClient(ctx, r.Meta(), "")
+	client, err := client.GetClient(ctx, r.Meta(), "", "")
 	if err != nil {
 		return err
 	}
@@ -271,7 +271,7 @@ func (r *SysConfigCORSResource) writeConfigToVault(ctx context.Context, data *Sy
 // readCORSConfig is a helper function that reads the CORS configuration from Vault
 // and populates the model. This is used by Create, Update, and Read operations.
 func (r *SysConfigCORSResource) readCORSConfig(ctx context.Context, data *SysConfigCORSModel, diags *diag.Diagnostics) error {
-	client, err := client.GetClient(ctx, r.Meta(), "")
+	client, err := client.GetClient(ctx, r.Meta(), "", "")
 	if err != nil {
 		return err
 	}
@@ -167,7 +167,7 @@ func (r *ConfigUIDefaultAuthResource) Create(ctx context.Context, req resource.C
 	}
 
 	// This endpoint must be called from root or administrative namespace
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -264,7 +264,7 @@ func (r *ConfigUIDefaultAuthResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// This endpoint must be called from root or administrative namespace
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -369,7 +369,7 @@ func (r *ConfigUIDefaultAuthResource) Update(ctx context.Context, req resource.U
 	}
 
 	// This endpoint must be called from root or administrative namespace
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -466,7 +466,7 @@ func (r *ConfigUIDefaultAuthResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// This endpoint must be called from root or administrative namespace
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -505,6 +505,8 @@ func (r *ConfigUIDefaultAuthResource) ImportState(ctx context.Context, req resou
 		)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
 	}
+
+	base.ImportClusterFromEnv(ctx, resp)
 }
 
 func (r *ConfigUIDefaultAuthResource) path(name string) string {
@@ -331,7 +331,7 @@ func (r *ConfigUIHeaderResource) ImportState(ctx context.Context, req resource.I
 // and returns the client. UI header configuration is a global setting that must be managed
 // from the root namespace.
 func (r *ConfigUIHeaderResource) getRootNamespaceClient(ctx context.Context) (*api.Client, error) {
-	vaultClient, err := client.GetClient(ctx, r.Meta(), "")
+	vaultClient, err := client.GetClient(ctx, r.Meta(), "", "")
 	if err != nil {
 		return nil, err
 	}
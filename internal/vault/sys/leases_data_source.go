@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &LeasesDataSource{}
+
+// NewLeasesDataSource returns the implementation for this data source
+func NewLeasesDataSource() datasource.DataSource {
+	return &LeasesDataSource{}
+}
+
+// LeasesDataSource implements the methods that define this data source
+type LeasesDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// LeasesModel describes the Terraform data source data model
+type LeasesModel struct {
+	base.BaseModel
+
+	Prefix    types.String   `tfsdk:"prefix"`
+	Recursive types.Bool     `tfsdk:"recursive"`
+	Keys      []types.String `tfsdk:"keys"`
+	LeaseIDs  []types.String `tfsdk:"lease_ids"`
+}
+
+func (d *LeasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leases"
+}
+
+func (d *LeasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPrefix: schema.StringAttribute{
+				MarkdownDescription: "The lease prefix to list, for example `aws/creds/deploy`.",
+				Required:            true,
+			},
+			consts.FieldRecursive: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to also list the leases of all the prefixes under `prefix`.",
+				Optional:            true,
+			},
+			consts.FieldKeys: schema.ListAttribute{
+				MarkdownDescription: "The keys found under `prefix`, relative to it. Keys ending with `/` are " +
+					"prefixes, which are only returned when `recursive` is not set.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			consts.FieldLeaseIDs: schema.ListAttribute{
+				MarkdownDescription: "The full IDs of the leases found under `prefix`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Lists the leases under a prefix.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *LeasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LeasesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	prefix := strings.Trim(data.Prefix.ValueString(), "/") + "/"
+	keys, err := listLeases(ctx, cli, prefix, "", data.Recursive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	data.Keys = make([]types.String, 0, len(keys))
+	data.LeaseIDs = make([]types.String, 0, len(keys))
+	for _, k := range keys {
+		data.Keys = append(data.Keys, types.StringValue(k))
+		if !strings.HasSuffix(k, "/") {
+			data.LeaseIDs = append(data.LeaseIDs, types.StringValue(prefix+k))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listLeases returns the keys under prefix+sub, relative to prefix. The
+// sub-prefixes are descended into when recursive is true.
+func listLeases(ctx context.Context, cli *api.Client, prefix, sub string, recursive bool) ([]string, error) {
+	path := "sys/leases/lookup/" + prefix + sub
+	tflog.Debug(ctx, "Listing leases", map[string]any{consts.FieldPath: path})
+	secret, err := cli.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, nil
+	}
+
+	raw, ok := secret.Data[consts.FieldKeys].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected list for %q, got %T", consts.FieldKeys, secret.Data[consts.FieldKeys])
+	}
+
+	var keys []string
+	for _, v := range raw {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string key, got %T", v)
+		}
+
+		k := sub + s
+		if !recursive || !strings.HasSuffix(k, "/") {
+			keys = append(keys, k)
+			continue
+		}
+
+		subKeys, err := listLeases(ctx, cli, prefix, k, recursive)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, subKeys...)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccLeasesDataSource(t *testing.T) {
+	role := acctest.RandomWithPrefix("tf-test-role")
+	dataSourceName := "data.vault_leases.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLeasesDataSourceConfig(role, "auth/token/create/"+role, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldPrefix, "auth/token/create/"+role),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldKeys+".#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldLeaseIDs+".#", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, consts.FieldLeaseIDs+".0",
+						regexp.MustCompile("^auth/token/create/"+role+"/.+")),
+				),
+			},
+			{
+				Config: testAccLeasesDataSourceConfig(role, "auth/token/create", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLeasesContain(dataSourceName, "auth/token/create/"+role+"/", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccLeasesDataSourceConfig(role, prefix string, recursive bool) string {
+	return fmt.Sprintf(`
+resource "vault_token_auth_backend_role" "role" {
+  role_name = "%s"
+  token_ttl = 3600
+}
+
+resource "vault_token" "first" {
+  role_name = vault_token_auth_backend_role.role.role_name
+  ttl       = "1h"
+}
+
+resource "vault_token" "second" {
+  role_name = vault_token_auth_backend_role.role.role_name
+  ttl       = "1h"
+}
+
+data "vault_leases" "test" {
+  prefix    = "%s"
+  recursive = %t
+
+  depends_on = [vault_token.first, vault_token.second]
+}
+`, role, prefix, recursive)
+}
+
+// testAccCheckLeasesContain checks that the data source lists count lease IDs
+// starting with prefix.
+func testAccCheckLeasesContain(name, prefix string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("data source %q not found in state", name)
+		}
+
+		var found int
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, consts.FieldLeaseIDs+".") && k != consts.FieldLeaseIDs+".#" && strings.HasPrefix(v, prefix) {
+				found++
+			}
+		}
+		if found != count {
+			return fmt.Errorf("expected %d lease IDs with prefix %q, got %d", count, prefix, found)
+		}
+
+		return nil
+	}
+}
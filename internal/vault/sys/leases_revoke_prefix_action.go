@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the action.ActionWithConfigure interface
+var _ action.ActionWithConfigure = &LeasesRevokePrefixAction{}
+
+// NewLeasesRevokePrefixAction returns the implementation for this action
+func NewLeasesRevokePrefixAction() action.Action {
+	return &LeasesRevokePrefixAction{}
+}
+
+// LeasesRevokePrefixAction revokes all the leases under a prefix.
+type LeasesRevokePrefixAction struct {
+	base.ActionWithConfigure
+}
+
+func (a *LeasesRevokePrefixAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leases_revoke_prefix"
+}
+
+func (a *LeasesRevokePrefixAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Revokes all the leases under a prefix.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPrefix: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The lease prefix to revoke, for example `aws/creds/deploy`.",
+			},
+			consts.FieldSync: schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Set to false to return as soon as the revocation is queued, instead of " +
+					"waiting for it to complete. Defaults to true.",
+			},
+		},
+	}
+
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *LeasesRevokePrefixAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var ns, cluster, prefix types.String
+	var sync types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldCluster), &cluster)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldPrefix), &prefix)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldSync), &sync)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), ns.ValueString(), cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	revokePath := fmt.Sprintf("sys/leases/revoke-prefix/%s", strings.Trim(prefix.ValueString(), "/"))
+	data := map[string]interface{}{}
+	if !sync.IsNull() {
+		data[consts.FieldSync] = sync.ValueBool()
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Revoking leases under %q", prefix.ValueString()),
+	})
+
+	tflog.Debug(ctx, "Revoking leases by prefix", map[string]any{
+		consts.FieldPath: revokePath,
+	})
+	if _, err := c.Logical().WriteWithContext(ctx, revokePath, data); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultInvokeErr(err))
+		return
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccLeasesRevokePrefixAction(t *testing.T) {
+	role := acctest.RandomWithPrefix("tf-test-role")
+	prefix := "auth/token/create/" + role
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if _, err := client.Logical().Write("auth/token/roles/"+role, nil); err != nil {
+						t.Fatal(err)
+					}
+					t.Cleanup(func() {
+						_, _ = client.Logical().Delete("auth/token/roles/" + role)
+					})
+
+					for i := 0; i < 2; i++ {
+						if _, err := client.Auth().Token().CreateWithRole(&api.TokenCreateRequest{
+							TTL: "1h",
+						}, role); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: fmt.Sprintf(`
+action "vault_leases_revoke_prefix" "revoke" {
+  config {
+    prefix = "%s"
+  }
+}
+
+resource "terraform_data" "revoke" {
+  input = "%s"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_leases_revoke_prefix.revoke]
+    }
+  }
+}
+`, prefix, prefix),
+				Check: testAccCheckLeasesRevoked(prefix),
+			},
+		},
+	})
+}
+
+func testAccCheckLeasesRevoked(prefix string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		secret, err := client.Logical().List("sys/leases/lookup/" + prefix + "/")
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			return nil
+		}
+
+		if keys, _ := secret.Data[consts.FieldKeys].([]interface{}); len(keys) > 0 {
+			return fmt.Errorf("expected no leases under %q, got %v", prefix, keys)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// builtinMountTypes are the secrets engines that are mounted by Vault itself
+// and cannot be managed with vault_mount.
+var builtinMountTypes = []string{
+	"cubbyhole",
+	"identity",
+	"system",
+	"ns_cubbyhole",
+	"ns_identity",
+	"ns_system",
+}
+
+// Ensure the implementation satisfies the list.ListResource interface
+var _ list.ListResourceWithRawV5Schemas = &MountListResource{}
+
+// NewMountListResource returns the implementation for this list resource
+func NewMountListResource() list.ListResource {
+	return &MountListResource{}
+}
+
+// MountListResource lists the secrets engines mounted in a namespace
+type MountListResource struct {
+	base.ListResourceWithSDKv2
+}
+
+// MountListModel describes the configuration of the mount and auth backend
+// list resources
+type MountListModel struct {
+	base.BaseModel
+
+	Type types.String `tfsdk:"type"`
+}
+
+func (r *MountListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount"
+}
+
+func (r *MountListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Only list secrets engines of this type.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Lists the secrets engines mounted in a namespace.",
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+func (r *MountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data MountListModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing secrets engines")
+	mounts, err := cli.Sys().ListMountsWithContext(ctx)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = r.ListResults(ctx, req, data.Namespace.ValueString(), data.Cluster.ValueString(),
+		mountListResults(mounts, data.Type.ValueString(), builtinMountTypes))
+}
+
+// mountListResults returns the mounts of type mountType, or all mounts if
+// mountType is empty. Mounts of the excluded types are skipped.
+func mountListResults(mounts map[string]*api.MountOutput, mountType string, excluded []string) []base.ListResult {
+	var results []base.ListResult
+	for path, mount := range mounts {
+		if slices.Contains(excluded, mount.Type) {
+			continue
+		}
+		if mountType != "" && mount.Type != mountType {
+			continue
+		}
+
+		path = strings.TrimSuffix(path, "/")
+		results = append(results, base.ListResult{
+			ID:          path,
+			DisplayName: path,
+		})
+	}
+
+	slices.SortFunc(results, func(a, b base.ListResult) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return results
+}
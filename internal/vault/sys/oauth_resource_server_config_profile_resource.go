@@ -282,7 +282,7 @@ func (r *OAuthResourceServerConfigProfileResource) Read(ctx context.Context, req
 		return
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -328,7 +328,7 @@ func (r *OAuthResourceServerConfigProfileResource) Delete(ctx context.Context, r
 		return
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -359,7 +359,9 @@ func (r *OAuthResourceServerConfigProfileResource) ImportState(ctx context.Conte
 		data.Namespace = types.StringValue(ns)
 	}
 
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	base.ImportClusterFromEnv(ctx, resp)
+
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
@@ -383,7 +385,7 @@ func (r *OAuthResourceServerConfigProfileResource) ImportState(ctx context.Conte
 // failure so callers can distinguish create from update. Errors are reported via
 // diags; callers should check diags.HasError after calling.
 func (r *OAuthResourceServerConfigProfileResource) writeProfile(ctx context.Context, data *OAuthResourceServerConfigProfileModel, diags *diag.Diagnostics, writeErr func(error) (string, string)) {
-	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	client, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		diags.AddError(errutil.ClientConfigureErr(err))
 		return
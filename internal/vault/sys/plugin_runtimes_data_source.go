@@ -79,13 +79,11 @@ func (d *pluginRuntimesDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 				MarkdownDescription: "Unique identifier for this data source.",
 			},
-			consts.FieldNamespace: schema.StringAttribute{
-				Optional:            true,
-				MarkdownDescription: "Target namespace. (requires Enterprise)",
-			},
 		},
 		MarkdownDescription: "Lists plugin runtimes registered in Vault's plugin runtimes catalog.",
 	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
 }
 
 func (d *pluginRuntimesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -95,7 +93,7 @@ func (d *pluginRuntimesDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString())
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
 		return
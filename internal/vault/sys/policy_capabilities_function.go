@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+// Ensure the implementation satisfies the function.Function interface
+var _ function.Function = &PolicyCapabilitiesFunction{}
+
+// NewPolicyCapabilitiesFunction returns the implementation for this function
+var NewPolicyCapabilitiesFunction = func() function.Function {
+	return &PolicyCapabilitiesFunction{}
+}
+
+// PolicyCapabilitiesFunction evaluates Vault HCL policy documents offline and
+// returns the capabilities they grant on a path.
+type PolicyCapabilitiesFunction struct{}
+
+func (f *PolicyCapabilitiesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_capabilities"
+}
+
+func (f *PolicyCapabilitiesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluate the capabilities of Vault policy documents on a path",
+		MarkdownDescription: "Evaluates a list of Vault HCL policy documents the way Vault evaluates the " +
+			"policies of a token, and returns the sorted capabilities they grant on a path, or `[\"deny\"]` " +
+			"if they grant none.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "The Vault HCL policy documents to evaluate.",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "The path to evaluate the capabilities on.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *PolicyCapabilitiesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var path string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &policies, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	acl, err := parseACL(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, acl.Capabilities(path)))
+}
+
+// parseACL parses the Vault HCL policy documents into a policy.ACL.
+func parseACL(policies []string) (*policy.ACL, error) {
+	parsed := make([]*policy.Policy, 0, len(policies))
+	for i, p := range policies {
+		pol, err := policy.Parse(p)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+		parsed = append(parsed, pol)
+	}
+
+	return policy.NewACL(parsed...), nil
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPolicyCapabilitiesFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  policies = [
+    <<-EOT
+    path "secret/*" {
+      capabilities = ["read", "list"]
+    }
+
+    path "secret/prod/*" {
+      capabilities = ["deny"]
+    }
+    EOT
+    ,
+    <<-EOT
+    path "secret/+/config" {
+      capabilities = ["update"]
+    }
+    EOT
+  ]
+}
+
+output "dev" {
+  value = provider::vault::policy_capabilities(local.policies, "secret/dev/app")
+}
+
+output "config" {
+  value = provider::vault::policy_capabilities(local.policies, "secret/dev/config")
+}
+
+output "prod" {
+  value = provider::vault::policy_capabilities(local.policies, "secret/prod/app")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("dev", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("list"),
+						knownvalue.StringExact("read"),
+					})),
+					statecheck.ExpectKnownOutputValue("config", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("update"),
+					})),
+					statecheck.ExpectKnownOutputValue("prod", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("deny"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::vault::policy_capabilities(["path \"secret/*\" { capabilities = [\"write\"] }"], "secret/foo")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid capability "write"`),
+			},
+		},
+	})
+}
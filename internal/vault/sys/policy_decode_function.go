@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+const (
+	fieldCapabilities        = "capabilities"
+	fieldRequiredParameters  = "required_parameters"
+	fieldSubscribeEventTypes = "subscribe_event_types"
+	fieldAllowedParameters   = "allowed_parameters"
+	fieldDeniedParameters    = "denied_parameters"
+	fieldMinWrappingTTL      = "min_wrapping_ttl"
+	fieldMaxWrappingTTL      = "max_wrapping_ttl"
+)
+
+// policyRuleAttrTypes describes a single policy rule as it is exchanged with
+// the policy provider functions.
+var policyRuleAttrTypes = map[string]attr.Type{
+	consts.FieldPath:         types.StringType,
+	consts.FieldDescription:  types.StringType,
+	fieldCapabilities:        types.ListType{ElemType: types.StringType},
+	fieldRequiredParameters:  types.ListType{ElemType: types.StringType},
+	fieldSubscribeEventTypes: types.ListType{ElemType: types.StringType},
+	fieldAllowedParameters:   types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	fieldDeniedParameters:    types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	fieldMinWrappingTTL:      types.StringType,
+	fieldMaxWrappingTTL:      types.StringType,
+}
+
+// policyRuleModel is the Go representation of policyRuleAttrTypes.
+type policyRuleModel struct {
+	Path                types.String        `tfsdk:"path"`
+	Description         types.String        `tfsdk:"description"`
+	Capabilities        []string            `tfsdk:"capabilities"`
+	RequiredParameters  []string            `tfsdk:"required_parameters"`
+	SubscribeEventTypes []string            `tfsdk:"subscribe_event_types"`
+	AllowedParameters   map[string][]string `tfsdk:"allowed_parameters"`
+	DeniedParameters    map[string][]string `tfsdk:"denied_parameters"`
+	MinWrappingTTL      types.String        `tfsdk:"min_wrapping_ttl"`
+	MaxWrappingTTL      types.String        `tfsdk:"max_wrapping_ttl"`
+}
+
+// Ensure the implementation satisfies the function.Function interface
+var _ function.Function = &PolicyDecodeFunction{}
+
+// NewPolicyDecodeFunction returns the implementation for this function
+var NewPolicyDecodeFunction = func() function.Function {
+	return &PolicyDecodeFunction{}
+}
+
+// PolicyDecodeFunction parses a Vault HCL policy document into a list of
+// rule objects.
+type PolicyDecodeFunction struct{}
+
+func (f *PolicyDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_decode"
+}
+
+func (f *PolicyDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a Vault policy document",
+		MarkdownDescription: "Parses a Vault HCL policy document into a list of rule objects. " +
+			"A comment directly above a `path` stanza is returned as the rule's `description`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hcl",
+				MarkdownDescription: "The Vault HCL policy document to parse.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: policyRuleAttrTypes},
+		},
+	}
+}
+
+func (f *PolicyDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hcl string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &hcl))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := policy.Parse(hcl)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	rules := make([]policyRuleModel, 0, len(p.Rules))
+	for _, rule := range p.Rules {
+		rules = append(rules, policyRuleModel{
+			Path:                types.StringValue(rule.Path),
+			Description:         stringValueOrNull(rule.Description),
+			Capabilities:        rule.Capabilities,
+			RequiredParameters:  rule.RequiredParameters,
+			SubscribeEventTypes: rule.SubscribeEventTypes,
+			AllowedParameters:   rule.AllowedParameters,
+			DeniedParameters:    rule.DeniedParameters,
+			MinWrappingTTL:      stringValueOrNull(rule.MinWrappingTTL),
+			MaxWrappingTTL:      stringValueOrNull(rule.MaxWrappingTTL),
+		})
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rules))
+}
+
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPolicyDecodeFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  hcl = <<-EOT
+    # allow reads
+    path "secret/*" {
+      capabilities = ["read", "list"]
+      denied_parameters = {
+        "*" = []
+      }
+    }
+  EOT
+}
+
+output "test" {
+  value = provider::vault::policy_decode(local.hcl)
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"path":                  knownvalue.StringExact("secret/*"),
+							"description":           knownvalue.StringExact("allow reads"),
+							"capabilities":          knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("read"), knownvalue.StringExact("list")}),
+							"required_parameters":   knownvalue.Null(),
+							"subscribe_event_types": knownvalue.Null(),
+							"allowed_parameters":    knownvalue.Null(),
+							"denied_parameters": knownvalue.MapExact(map[string]knownvalue.Check{
+								"*": knownvalue.ListSizeExact(0),
+							}),
+							"min_wrapping_ttl": knownvalue.Null(),
+							"max_wrapping_ttl": knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+			{
+				// round trip through policy_encode
+				Config: `
+locals {
+  rules = [
+    {
+      path         = "auth/token/create"
+      capabilities = ["update", "sudo"]
+    },
+  ]
+}
+
+output "test" {
+  value = provider::vault::policy_encode(provider::vault::policy_decode(provider::vault::policy_encode(local.rules)))
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(
+						"path \"auth/token/create\" {\n  capabilities = [\"update\", \"sudo\"]\n}\n",
+					)),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::vault::policy_decode("path \"secret/*\" { capabilities = [\"write\"] }")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid capability "write"`),
+			},
+		},
+	})
+}
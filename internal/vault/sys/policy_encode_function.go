@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+// Ensure the implementation satisfies the function.Function interface
+var _ function.Function = &PolicyEncodeFunction{}
+
+// NewPolicyEncodeFunction returns the implementation for this function
+var NewPolicyEncodeFunction = func() function.Function {
+	return &PolicyEncodeFunction{}
+}
+
+// PolicyEncodeFunction renders a list of rule objects as a Vault HCL policy
+// document.
+type PolicyEncodeFunction struct{}
+
+func (f *PolicyEncodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_encode"
+}
+
+func (f *PolicyEncodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a Vault policy document",
+		MarkdownDescription: "Renders a list of rule objects as a Vault HCL policy document. " +
+			"Each rule requires `path` and `capabilities`; all other attributes are optional. " +
+			"The output is identical to the `hcl` attribute of the `vault_policy_document` data source.",
+		Parameters: []function.Parameter{
+			// Dynamic so that each rule object only has to set the attributes it needs.
+			function.DynamicParameter{
+				Name:                "rules",
+				MarkdownDescription: "A list of policy rule objects.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PolicyEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rules types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rules))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := policyFromValue(rules.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, policy.Render(p)))
+}
+
+// policyFromValue converts a list or tuple of rule objects into a
+// policy.Policy.
+func policyFromValue(v attr.Value) (*policy.Policy, error) {
+	elems, err := elementsFromValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("rules: %w", err)
+	}
+
+	p := &policy.Policy{}
+	for i, elem := range elems {
+		rule, err := policyRuleFromValue(elem)
+		if err != nil {
+			return nil, fmt.Errorf("rules[%d]: %w", i, err)
+		}
+		p.Rules = append(p.Rules, rule)
+	}
+
+	return p, nil
+}
+
+func policyRuleFromValue(v attr.Value) (*policy.PolicyRule, error) {
+	attrs, err := attributesFromValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range attrs {
+		if _, ok := policyRuleAttrTypes[k]; !ok {
+			return nil, fmt.Errorf("unsupported attribute %q", k)
+		}
+	}
+
+	rule := &policy.PolicyRule{}
+	if rule.Path, err = stringFromAttributes(attrs, consts.FieldPath); err != nil {
+		return nil, err
+	}
+	if rule.Path == "" {
+		return nil, fmt.Errorf("missing required attribute %q", consts.FieldPath)
+	}
+
+	if rule.Capabilities, err = stringsFromAttributes(attrs, fieldCapabilities); err != nil {
+		return nil, err
+	}
+	if len(rule.Capabilities) == 0 {
+		return nil, fmt.Errorf("missing required attribute %q", fieldCapabilities)
+	}
+	for _, c := range rule.Capabilities {
+		if !policy.IsValidCapability(c) {
+			return nil, fmt.Errorf("invalid capability %q", c)
+		}
+	}
+
+	optionalStrings := map[string]*string{
+		consts.FieldDescription: &rule.Description,
+		fieldMinWrappingTTL:     &rule.MinWrappingTTL,
+		fieldMaxWrappingTTL:     &rule.MaxWrappingTTL,
+	}
+	for k, ptr := range optionalStrings {
+		if *ptr, err = stringFromAttributes(attrs, k); err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.RequiredParameters, err = stringsFromAttributes(attrs, fieldRequiredParameters); err != nil {
+		return nil, err
+	}
+	if rule.SubscribeEventTypes, err = stringsFromAttributes(attrs, fieldSubscribeEventTypes); err != nil {
+		return nil, err
+	}
+	if rule.AllowedParameters, err = parametersFromAttributes(attrs, fieldAllowedParameters); err != nil {
+		return nil, err
+	}
+	if rule.DeniedParameters, err = parametersFromAttributes(attrs, fieldDeniedParameters); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func elementsFromValue(v attr.Value) ([]attr.Value, error) {
+	switch t := v.(type) {
+	case basetypes.TupleValue:
+		return t.Elements(), nil
+	case basetypes.ListValue:
+		return t.Elements(), nil
+	case basetypes.SetValue:
+		return t.Elements(), nil
+	default:
+		return nil, fmt.Errorf("expected a list, got %s", v.Type(context.Background()))
+	}
+}
+
+func attributesFromValue(v attr.Value) (map[string]attr.Value, error) {
+	switch t := v.(type) {
+	case basetypes.ObjectValue:
+		return t.Attributes(), nil
+	case basetypes.MapValue:
+		return t.Elements(), nil
+	default:
+		return nil, fmt.Errorf("expected an object, got %s", v.Type(context.Background()))
+	}
+}
+
+func stringFromAttributes(attrs map[string]attr.Value, k string) (string, error) {
+	v, ok := attrs[k]
+	if !ok || v.IsNull() {
+		return "", nil
+	}
+
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return "", fmt.Errorf("%s: expected a string, got %s", k, v.Type(context.Background()))
+	}
+
+	return s.ValueString(), nil
+}
+
+func stringsFromAttributes(attrs map[string]attr.Value, k string) ([]string, error) {
+	v, ok := attrs[k]
+	if !ok || v.IsNull() {
+		return nil, nil
+	}
+
+	return stringsFromValue(k, v)
+}
+
+func stringsFromValue(k string, v attr.Value) ([]string, error) {
+	elems, err := elementsFromValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", k, err)
+	}
+
+	result := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		s, ok := elem.(basetypes.StringValue)
+		if !ok || s.IsNull() {
+			return nil, fmt.Errorf("%s: expected a list of strings", k)
+		}
+		result = append(result, s.ValueString())
+	}
+
+	return result, nil
+}
+
+func parametersFromAttributes(attrs map[string]attr.Value, k string) (map[string][]string, error) {
+	v, ok := attrs[k]
+	if !ok || v.IsNull() {
+		return nil, nil
+	}
+
+	params, err := attributesFromValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", k, err)
+	}
+
+	keys := make([]string, 0, len(params))
+	for name := range params {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string][]string, len(params))
+	for _, name := range keys {
+		values, err := stringsFromValue(fmt.Sprintf("%s.%s", k, name), params[name])
+		if err != nil {
+			return nil, err
+		}
+		result[name] = values
+	}
+
+	return result, nil
+}
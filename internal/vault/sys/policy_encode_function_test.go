@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPolicyEncodeFunction(t *testing.T) {
+	expected := `# test rule 1
+path "secret/test1/*" {
+  capabilities = ["create", "read"]
+  required_parameters = ["test_param1"]
+  allowed_parameters = {
+    "eggs" = ["foo", "bar"]
+    "spam" = ["eggs"]
+  }
+  max_wrapping_ttl = "1h"
+}
+
+path "secret/test2/*" {
+  capabilities = ["list"]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::vault::policy_encode([
+    {
+      path                = "secret/test1/*"
+      description         = "test rule 1"
+      capabilities        = ["create", "read"]
+      required_parameters = ["test_param1"]
+      allowed_parameters = {
+        spam = ["eggs"]
+        eggs = ["foo", "bar"]
+      }
+      max_wrapping_ttl = "1h"
+    },
+    {
+      path         = "secret/test2/*"
+      capabilities = ["list"]
+    },
+  ])
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(expected)),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::vault::policy_encode([
+    {
+      path         = "secret/*"
+      capabilities = ["write"]
+    },
+  ])
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid capability "write"`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::vault::policy_encode([
+    {
+      capabilities = ["read"]
+    },
+  ])
+}
+`,
+				ExpectError: regexp.MustCompile(`missing required attribute "path"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+const (
+	fieldCapability  = "capability"
+	fieldMatchedPath = "matched_path"
+	fieldAllowed     = "allowed"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &PolicyEvaluationDataSource{}
+
+// NewPolicyEvaluationDataSource returns the implementation for this data source
+func NewPolicyEvaluationDataSource() datasource.DataSource {
+	return &PolicyEvaluationDataSource{}
+}
+
+// PolicyEvaluationDataSource evaluates Vault HCL policy documents offline. It
+// does not need a Vault server, so it is not configured with the client.
+type PolicyEvaluationDataSource struct{}
+
+// PolicyEvaluationModel describes the Terraform data source data model
+type PolicyEvaluationModel struct {
+	Policies     []types.String          `tfsdk:"policies"`
+	Path         types.String            `tfsdk:"path"`
+	Capability   types.String            `tfsdk:"capability"`
+	Parameters   map[string]types.String `tfsdk:"parameters"`
+	Capabilities []types.String          `tfsdk:"capabilities"`
+	MatchedPath  types.String            `tfsdk:"matched_path"`
+	Allowed      types.Bool              `tfsdk:"allowed"`
+}
+
+func (d *PolicyEvaluationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_evaluation"
+}
+
+func (d *PolicyEvaluationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPolicies: schema.ListAttribute{
+				MarkdownDescription: "The Vault HCL policy documents to evaluate, e.g. the `hcl` of " +
+					"`vault_policy_document` data sources.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "The path to evaluate the policies on.",
+				Required:            true,
+			},
+			fieldCapability: schema.StringAttribute{
+				MarkdownDescription: "The capability of a request to evaluate, e.g. `update`. " +
+					"Required to compute `allowed`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policy.AllowedCapabilities...),
+				},
+			},
+			consts.FieldParameters: schema.MapAttribute{
+				MarkdownDescription: "The parameters of the request to evaluate, checked against the " +
+					"allowed, denied and required parameters of the matching rule.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot(fieldCapability)),
+				},
+			},
+			fieldCapabilities: schema.ListAttribute{
+				MarkdownDescription: "The sorted capabilities granted on the path, or `[\"deny\"]` if none is granted.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			fieldMatchedPath: schema.StringAttribute{
+				MarkdownDescription: "The path of the rule that applies to the path, if any.",
+				Computed:            true,
+			},
+			fieldAllowed: schema.BoolAttribute{
+				MarkdownDescription: "Whether a request with `capability` and `parameters` on the path is allowed.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Evaluates Vault policy documents offline, the way Vault evaluates the policies " +
+			"of a token, without a Vault server.",
+	}
+}
+
+func (d *PolicyEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyEvaluationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies := make([]string, 0, len(data.Policies))
+	for _, p := range data.Policies {
+		policies = append(policies, p.ValueString())
+	}
+
+	acl, err := parseACL(policies)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(consts.FieldPolicies), "Invalid policy", err.Error())
+		return
+	}
+
+	p := data.Path.ValueString()
+
+	data.Capabilities = make([]types.String, 0)
+	for _, c := range acl.Capabilities(p) {
+		data.Capabilities = append(data.Capabilities, types.StringValue(c))
+	}
+
+	data.MatchedPath = types.StringNull()
+	if rule := acl.Rule(p); rule != nil {
+		data.MatchedPath = types.StringValue(rule.Path)
+	}
+
+	data.Allowed = types.BoolNull()
+	if capability := data.Capability.ValueString(); capability != "" {
+		parameters := make(map[string]string, len(data.Parameters))
+		for k, v := range data.Parameters {
+			parameters[k] = v.ValueString()
+		}
+		data.Allowed = types.BoolValue(acl.Allowed(p, capability, parameters))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPolicyEvaluationDataSource(t *testing.T) {
+	allowed := "data.vault_policy_evaluation.allowed"
+	denied := "data.vault_policy_evaluation.denied"
+	unmatched := "data.vault_policy_evaluation.unmatched"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(allowed, "capabilities.#", "2"),
+					resource.TestCheckResourceAttr(allowed, "capabilities.0", "create"),
+					resource.TestCheckResourceAttr(allowed, "capabilities.1", "update"),
+					resource.TestCheckResourceAttr(allowed, "matched_path", "secret/+/config"),
+					resource.TestCheckResourceAttr(allowed, "allowed", "true"),
+					resource.TestCheckResourceAttr(denied, "matched_path", "secret/+/config"),
+					resource.TestCheckResourceAttr(denied, "allowed", "false"),
+					resource.TestCheckResourceAttr(unmatched, "capabilities.#", "1"),
+					resource.TestCheckResourceAttr(unmatched, "capabilities.0", "deny"),
+					resource.TestCheckNoResourceAttr(unmatched, "matched_path"),
+					resource.TestCheckNoResourceAttr(unmatched, "allowed"),
+					resource.TestCheckResourceAttr(unmatched, consts.FieldPath, "sys/mounts"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyEvaluationDataSourceConfig = `
+data "vault_policy_document" "test" {
+  rule {
+    path         = "secret/+/config"
+    capabilities = ["create", "update"]
+    allowed_parameter {
+      key   = "ttl"
+      value = ["1h", "2h"]
+    }
+  }
+}
+
+data "vault_policy_evaluation" "allowed" {
+  policies   = [data.vault_policy_document.test.hcl]
+  path       = "secret/app/config"
+  capability = "update"
+  parameters = {
+    ttl = "1h"
+  }
+}
+
+data "vault_policy_evaluation" "denied" {
+  policies   = [data.vault_policy_document.test.hcl]
+  path       = "secret/app/config"
+  capability = "update"
+  parameters = {
+    ttl = "24h"
+  }
+}
+
+data "vault_policy_evaluation" "unmatched" {
+  policies = [data.vault_policy_document.test.hcl]
+  path     = "sys/mounts"
+}
+`
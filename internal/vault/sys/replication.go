@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
+)
+
+const (
+	replicationTypePerformance = "performance"
+	replicationTypeDR          = "dr"
+
+	replicationModePrimary   = "primary"
+	replicationModeSecondary = "secondary"
+	replicationModeDisabled  = "disabled"
+
+	// replicationStateStreamWALs is the state of a secondary that is in sync
+	// with its primary.
+	replicationStateStreamWALs = "stream-wals"
+
+	replicationWaitTimeout      = 10 * time.Minute
+	replicationStatusPollPeriod = 2 * time.Second
+)
+
+var replicationTypes = []string{replicationTypePerformance, replicationTypeDR}
+
+// replicationStatusAPIModel describes the Vault API response of
+// sys/replication/<type>/status. The fields of a primary and of a secondary
+// are both included.
+type replicationStatusAPIModel struct {
+	Mode                     string                         `json:"mode"`
+	State                    string                         `json:"state"`
+	ClusterID                string                         `json:"cluster_id"`
+	PrimaryClusterAddr       string                         `json:"primary_cluster_addr"`
+	ConnectionState          string                         `json:"connection_state"`
+	SecondaryID              string                         `json:"secondary_id"`
+	KnownSecondaries         []string                       `json:"known_secondaries"`
+	KnownPrimaryClusterAddrs []string                       `json:"known_primary_cluster_addrs"`
+	LastWAL                  int64                          `json:"last_wal"`
+	LastRemoteWAL            int64                          `json:"last_remote_wal"`
+	MerkleRoot               string                         `json:"merkle_root"`
+	Secondaries              []replicationSecondaryAPIModel `json:"secondaries"`
+}
+
+type replicationSecondaryAPIModel struct {
+	NodeID           string `json:"node_id"`
+	APIAddress       string `json:"api_address"`
+	ClusterAddress   string `json:"cluster_address"`
+	ConnectionStatus string `json:"connection_status"`
+	LastHeartbeat    string `json:"last_heartbeat"`
+}
+
+func replicationPath(replicationType string, elems ...string) string {
+	return strings.Join(append([]string{"sys/replication", replicationType}, elems...), "/")
+}
+
+// readReplicationStatus reads the replication status of the cluster. The
+// status endpoint is unauthenticated, so it can be read while the cluster
+// is joining a primary and the provider's token is not valid yet. Since the
+// cluster may be briefly unavailable after its replication mode changes,
+// requests are retried like Client Controlled Consistency requests, up to
+// maxRetries times. The status is polled for changes, so it is never cached.
+func readReplicationStatus(ctx context.Context, cli *api.Client, maxRetries int, replicationType string) (*replicationStatusAPIModel, error) {
+	cli, err := helper.DisableReadCache(cli)
+	if err != nil {
+		return nil, fmt.Errorf("error cloning client: %w", err)
+	}
+	entity.SetupCCCRetryClient(cli, maxRetries)
+
+	statusPath := replicationPath(replicationType, "status")
+	tflog.Debug(ctx, "Reading replication status", map[string]any{consts.FieldPath: statusPath})
+	secret, err := cli.Logical().ReadWithContext(ctx, statusPath)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no replication status returned from %q", statusPath)
+	}
+
+	var status replicationStatusAPIModel
+	if err := model.ToAPIModel(secret.Data, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// waitForReplication polls the replication status of the cluster until ready
+// returns true for it, and returns the last status.
+func waitForReplication(ctx context.Context, cli *api.Client, maxRetries int, replicationType string, ready func(*replicationStatusAPIModel) bool) (*replicationStatusAPIModel, error) {
+	ctx, cancel := context.WithTimeout(ctx, replicationWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(replicationStatusPollPeriod)
+	defer ticker.Stop()
+
+	var last *replicationStatusAPIModel
+	for {
+		status, err := readReplicationStatus(ctx, cli, maxRetries, replicationType)
+		if err != nil && ctx.Err() == nil {
+			return nil, err
+		}
+		if status != nil {
+			if ready(status) {
+				return status, nil
+			}
+			last = status
+
+			tflog.Debug(ctx, "Waiting for replication", map[string]any{
+				consts.FieldReplicationType: replicationType,
+				consts.FieldMode:            status.Mode,
+				consts.FieldState:           status.State,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			if last == nil {
+				return nil, fmt.Errorf("timed out after %s waiting for %s replication", replicationWaitTimeout, replicationType)
+			}
+			return nil, fmt.Errorf("timed out after %s waiting for %s replication, mode %q, state %q",
+				replicationWaitTimeout, replicationType, last.Mode, last.State)
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicationModeIs returns a readiness check for waitForReplication that
+// succeeds once the cluster is in the given mode.
+func replicationModeIs(mode string) func(*replicationStatusAPIModel) bool {
+	return func(status *replicationStatusAPIModel) bool {
+		return status.Mode == mode
+	}
+}
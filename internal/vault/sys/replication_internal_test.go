@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func TestWaitForReplication(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/replication/performance/status" {
+			http.NotFound(w, r)
+			return
+		}
+		// the status is polled, so it must bypass the read cache
+		if got := r.Header.Get("Cache-Control"); got != "no-cache" {
+			t.Errorf("expected the Cache-Control header to be %q, got %q", "no-cache", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{"data": {"mode": "disabled"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {
+  "mode": "primary",
+  "state": "running",
+  "cluster_id": "b8a3e4f1",
+  "known_secondaries": ["dc2"],
+  "last_wal": 42,
+  "secondaries": [{"node_id": "dc2", "api_address": "https://dc2:8200", "connection_status": "connected"}]
+}}`))
+	}))
+	defer ts.Close()
+
+	config := api.DefaultConfig()
+	config.Address = ts.URL
+	cli, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := waitForReplication(context.Background(), cli, provider.DefaultMaxHTTPRetriesCCC, replicationTypePerformance, replicationModeIs(replicationModePrimary))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 status requests, got %d", got)
+	}
+	if status.Mode != replicationModePrimary || status.ClusterID != "b8a3e4f1" || status.LastWAL != 42 {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if len(status.KnownSecondaries) != 1 || status.KnownSecondaries[0] != "dc2" {
+		t.Errorf("unexpected known secondaries %v", status.KnownSecondaries)
+	}
+	if len(status.Secondaries) != 1 || status.Secondaries[0].APIAddress != "https://dc2:8200" {
+		t.Errorf("unexpected secondaries %+v", status.Secondaries)
+	}
+}
+
+func TestSecondaryActivationToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		secret       *api.Secret
+		wantToken    string
+		wantAccessor string
+		wantOK       bool
+	}{
+		{
+			name: "response-wrapping token",
+			secret: &api.Secret{
+				WrapInfo: &api.SecretWrapInfo{Token: "hvs.wrapped", Accessor: "accessor"},
+			},
+			wantToken:    "hvs.wrapped",
+			wantAccessor: "accessor",
+			wantOK:       true,
+		},
+		{
+			name: "encrypted token",
+			secret: &api.Secret{
+				Data: map[string]interface{}{"token": "encrypted"},
+			},
+			wantToken: "encrypted",
+			wantOK:    true,
+		},
+		{
+			name:   "no token",
+			secret: &api.Secret{Data: map[string]interface{}{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, accessor, ok := secondaryActivationToken(tt.secret)
+			if token != tt.wantToken || accessor != tt.wantAccessor || ok != tt.wantOK {
+				t.Errorf("secondaryActivationToken() = (%q, %q, %v), want (%q, %q, %v)",
+					token, accessor, ok, tt.wantToken, tt.wantAccessor, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &ReplicationPerformancePathsFilterResource{}
+	_ resource.ResourceWithImportState = &ReplicationPerformancePathsFilterResource{}
+)
+
+// NewReplicationPerformancePathsFilterResource returns the implementation for this resource
+func NewReplicationPerformancePathsFilterResource() resource.Resource {
+	return &ReplicationPerformancePathsFilterResource{}
+}
+
+// ReplicationPerformancePathsFilterResource manages the paths filter of a performance
+// secondary on its primary.
+type ReplicationPerformancePathsFilterResource struct {
+	base.ResourceWithConfigure
+}
+
+// ReplicationPerformancePathsFilterModel describes the Terraform resource data model
+type ReplicationPerformancePathsFilterModel struct {
+	base.BaseModel
+
+	SecondaryID types.String `tfsdk:"secondary_id"`
+	Mode        types.String `tfsdk:"mode"`
+	Paths       types.Set    `tfsdk:"paths"`
+}
+
+// replicationPerformancePathsFilterAPIModel describes the Vault API response for a
+// paths filter
+type replicationPerformancePathsFilterAPIModel struct {
+	Mode  string   `json:"mode"`
+	Paths []string `json:"paths"`
+}
+
+func (r *ReplicationPerformancePathsFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_performance_paths_filter"
+}
+
+func (r *ReplicationPerformancePathsFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldSecondaryID: schema.StringAttribute{
+				MarkdownDescription: "The ID of the performance secondary, as given when its activation " +
+					"token was issued.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldMode: schema.StringAttribute{
+				MarkdownDescription: "Whether `paths` are the only paths replicated to the secondary, `allow`, " +
+					"or the paths that are not, `deny`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny"),
+				},
+			},
+			consts.FieldPaths: schema.SetAttribute{
+				MarkdownDescription: "The mount paths or namespaces to filter.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		MarkdownDescription: "Manages the paths filter of a performance replication secondary on its primary.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+func (r *ReplicationPerformancePathsFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReplicationPerformancePathsFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &plan, errutil.VaultCreateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReplicationPerformancePathsFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReplicationPerformancePathsFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	filterPath := replicationPerformancePathsFilterPath(state.SecondaryID.ValueString())
+	tflog.Debug(ctx, "Reading replication paths filter", map[string]any{consts.FieldPath: filterPath})
+	secret, err := cli.Logical().ReadWithContext(ctx, filterPath)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		tflog.Warn(ctx, "Replication paths filter not found, removing from state", map[string]any{consts.FieldPath: filterPath})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var apiModel replicationPerformancePathsFilterAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiModel); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	state.Mode = types.StringValue(apiModel.Mode)
+	paths, diags := types.SetValueFrom(ctx, types.StringType, apiModel.Paths)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Paths = paths
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReplicationPerformancePathsFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ReplicationPerformancePathsFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &plan, errutil.VaultUpdateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReplicationPerformancePathsFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReplicationPerformancePathsFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	filterPath := replicationPerformancePathsFilterPath(state.SecondaryID.ValueString())
+	tflog.Debug(ctx, "Deleting replication paths filter", map[string]any{consts.FieldPath: filterPath})
+	if _, err := cli.Logical().DeleteWithContext(ctx, filterPath); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+func (r *ReplicationPerformancePathsFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldSecondaryID), req.ID)...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+
+	base.ImportClusterFromEnv(ctx, resp)
+}
+
+func (r *ReplicationPerformancePathsFilterResource) write(ctx context.Context, data *ReplicationPerformancePathsFilterModel, errFunc func(error) (string, string)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	var paths []string
+	diags.Append(data.Paths.ElementsAs(ctx, &paths, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	filterPath := replicationPerformancePathsFilterPath(data.SecondaryID.ValueString())
+	tflog.Debug(ctx, "Writing replication paths filter", map[string]any{consts.FieldPath: filterPath})
+	if _, err := cli.Logical().WriteWithContext(ctx, filterPath, map[string]interface{}{
+		consts.FieldMode:  data.Mode.ValueString(),
+		consts.FieldPaths: paths,
+	}); err != nil {
+		diags.AddError(errFunc(err))
+	}
+
+	return diags
+}
+
+func replicationPerformancePathsFilterPath(secondaryID string) string {
+	return replicationPath(replicationTypePerformance, replicationModePrimary, "paths-filter", secondaryID)
+}
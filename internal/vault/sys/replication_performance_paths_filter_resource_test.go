@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccReplicationPerformancePathsFilter(t *testing.T) {
+	resourceName := "vault_replication_performance_paths_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestEntPreCheck(t)
+			testutil.SkipTestEnvUnset(t, envVarTFAccReplication)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationPerformancePathsFilterConfig("allow", `"kv-app/"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecondaryID, "tf-test-secondary"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMode, "allow"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "kv-app/"),
+				),
+			},
+			{
+				Config: testAccReplicationPerformancePathsFilterConfig("deny", `"kv-app/", "kv-ops/"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMode, "deny"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "kv-app/"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "kv-ops/"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        "tf-test-secondary",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: consts.FieldSecondaryID,
+			},
+		},
+	})
+}
+
+func testAccReplicationPerformancePathsFilterConfig(mode, paths string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_primary" "test" {
+  replication_type = "performance"
+}
+
+resource "vault_replication_performance_paths_filter" "test" {
+  secondary_id = "tf-test-secondary"
+  mode         = "%s"
+  paths        = [%s]
+
+  depends_on = [vault_replication_primary.test]
+}
+`, mode, paths)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &ReplicationPrimaryResource{}
+	_ resource.ResourceWithImportState = &ReplicationPrimaryResource{}
+)
+
+// NewReplicationPrimaryResource returns the implementation for this resource
+func NewReplicationPrimaryResource() resource.Resource {
+	return &ReplicationPrimaryResource{}
+}
+
+// ReplicationPrimaryResource enables performance or DR replication on the
+// cluster as a primary.
+type ReplicationPrimaryResource struct {
+	base.ResourceWithConfigure
+}
+
+// ReplicationPrimaryModel describes the Terraform resource data model
+type ReplicationPrimaryModel struct {
+	base.BaseModel
+
+	ReplicationType    types.String `tfsdk:"replication_type"`
+	PrimaryClusterAddr types.String `tfsdk:"primary_cluster_addr"`
+	ClusterID          types.String `tfsdk:"cluster_id"`
+}
+
+func (r *ReplicationPrimaryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_primary"
+}
+
+func (r *ReplicationPrimaryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldReplicationType: schema.StringAttribute{
+				MarkdownDescription: "The type of replication to enable, `performance` or `dr`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(replicationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldPrimaryClusterAddr: schema.StringAttribute{
+				MarkdownDescription: "The cluster address that the secondaries connect to. Defaults to the " +
+					"cluster address of the active node.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldClusterID: schema.StringAttribute{
+				MarkdownDescription: "The ID of the replicated cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Enables performance or DR replication on the cluster as a primary.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+func (r *ReplicationPrimaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReplicationPrimaryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	replicationType := plan.ReplicationType.ValueString()
+	data := map[string]interface{}{}
+	if v := plan.PrimaryClusterAddr.ValueString(); v != "" {
+		data[consts.FieldPrimaryClusterAddr] = v
+	}
+
+	enablePath := replicationPath(replicationType, replicationModePrimary, "enable")
+	tflog.Debug(ctx, "Enabling replication primary", map[string]any{consts.FieldPath: enablePath})
+	if _, err := cli.Logical().WriteWithContext(ctx, enablePath, data); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, r.Meta(), plan.Cluster.ValueString())
+	status, err := waitForReplication(ctx, cli, maxRetries, replicationType, replicationModeIs(replicationModePrimary))
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	plan.ClusterID = types.StringValue(status.ClusterID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReplicationPrimaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReplicationPrimaryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, r.Meta(), state.Cluster.ValueString())
+	status, err := readReplicationStatus(ctx, cli, maxRetries, state.ReplicationType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if status.Mode != replicationModePrimary {
+		tflog.Warn(ctx, "Replication primary is not enabled, removing from state", map[string]any{
+			consts.FieldReplicationType: state.ReplicationType.ValueString(),
+			consts.FieldMode:            status.Mode,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ClusterID = types.StringValue(status.ClusterID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReplicationPrimaryResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update not supported", "Replication primaries cannot be updated, they must be replaced")
+}
+
+func (r *ReplicationPrimaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReplicationPrimaryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	replicationType := state.ReplicationType.ValueString()
+	disablePath := replicationPath(replicationType, replicationModePrimary, "disable")
+	tflog.Debug(ctx, "Disabling replication primary", map[string]any{consts.FieldPath: disablePath})
+	if _, err := cli.Logical().WriteWithContext(ctx, disablePath, nil); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+		return
+	}
+
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, r.Meta(), state.Cluster.ValueString())
+	if _, err := waitForReplication(ctx, cli, maxRetries, replicationType, replicationModeIs(replicationModeDisabled)); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+func (r *ReplicationPrimaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !slices.Contains(replicationTypes, req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be the replication type, one of %q, got: %q", replicationTypes, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldReplicationType), req.ID)...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+
+	base.ImportClusterFromEnv(ctx, resp)
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// envVarTFAccReplication enables the replication tests, which change the
+// replication mode of the test cluster.
+const envVarTFAccReplication = "TF_ACC_REPLICATION"
+
+func TestAccReplicationPrimary(t *testing.T) {
+	resourceName := "vault_replication_primary.test"
+	dataSourceName := "data.vault_replication_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestEntPreCheck(t)
+			testutil.SkipTestEnvUnset(t, envVarTFAccReplication)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationPrimaryConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldReplicationType, "performance"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldClusterID),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldMode, "primary"),
+					resource.TestCheckResourceAttrPair(dataSourceName, consts.FieldClusterID, resourceName, consts.FieldClusterID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        "performance",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: consts.FieldReplicationType,
+			},
+		},
+	})
+}
+
+func testAccReplicationPrimaryConfig() string {
+	return `
+resource "vault_replication_primary" "test" {
+  replication_type = "performance"
+}
+
+data "vault_replication_status" "test" {
+  replication_type = "performance"
+
+  depends_on = [vault_replication_primary.test]
+}
+`
+}
@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &ReplicationSecondaryResource{}
+	_ resource.ResourceWithImportState    = &ReplicationSecondaryResource{}
+	_ resource.ResourceWithValidateConfig = &ReplicationSecondaryResource{}
+)
+
+// errReplicationSecondaryDR explains why DR secondaries are not managed by
+// the resource.
+const errReplicationSecondaryDR = "DR secondaries cannot be managed by this resource. A DR secondary rejects " +
+	"requests authenticated with a token, so it can only be disabled with a DR operation token, which " +
+	"Terraform cannot supply when the resource is destroyed. Activate DR secondaries outside of Terraform."
+
+// NewReplicationSecondaryResource returns the implementation for this resource
+func NewReplicationSecondaryResource() resource.Resource {
+	return &ReplicationSecondaryResource{}
+}
+
+// ReplicationSecondaryResource activates the cluster as a performance
+// secondary of a primary, with a secondary activation token. DR secondaries
+// are rejected, see errReplicationSecondaryDR.
+type ReplicationSecondaryResource struct {
+	base.ResourceWithConfigure
+}
+
+// ReplicationSecondaryModel describes the Terraform resource data model
+type ReplicationSecondaryModel struct {
+	base.BaseModel
+
+	ReplicationType types.String `tfsdk:"replication_type"`
+	TokenWO         types.String `tfsdk:"token_wo"`
+	PrimaryAPIAddr  types.String `tfsdk:"primary_api_addr"`
+	CAFile          types.String `tfsdk:"ca_file"`
+	CAPath          types.String `tfsdk:"ca_path"`
+	ClusterID       types.String `tfsdk:"cluster_id"`
+	SecondaryID     types.String `tfsdk:"secondary_id"`
+}
+
+func (r *ReplicationSecondaryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_secondary"
+}
+
+func (r *ReplicationSecondaryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldReplicationType: schema.StringAttribute{
+				MarkdownDescription: "The type of replication to activate. Only `performance` is supported, " +
+					"DR secondaries must be activated outside of Terraform.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(replicationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldTokenWO: schema.StringAttribute{
+				MarkdownDescription: "The secondary activation token issued by the primary, e.g. by the " +
+					"`vault_replication_secondary_token` ephemeral resource. It is only used on creation.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			consts.FieldPrimaryAPIAddr: schema.StringAttribute{
+				MarkdownDescription: "The API address of the primary. Defaults to the address in the " +
+					"activation token.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldCAFile: schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA file on the secondary, used to verify the " +
+					"TLS certificate of the primary's API address.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldCAPath: schema.StringAttribute{
+				MarkdownDescription: "Path to a directory of PEM-encoded CA files on the secondary, used to " +
+					"verify the TLS certificate of the primary's API address.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldClusterID: schema.StringAttribute{
+				MarkdownDescription: "The ID of the replicated cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.FieldSecondaryID: schema.StringAttribute{
+				MarkdownDescription: "The ID of the secondary, as known by the primary.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Activates the cluster as a performance secondary of a primary.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+func (r *ReplicationSecondaryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var replicationType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldReplicationType), &replicationType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if replicationType.ValueString() == replicationTypeDR {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(consts.FieldReplicationType),
+			"Unsupported replication type",
+			errReplicationSecondaryDR,
+		)
+	}
+}
+
+func (r *ReplicationSecondaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReplicationSecondaryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldTokenWO), &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString(), plan.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	replicationType := plan.ReplicationType.ValueString()
+	data := map[string]interface{}{
+		consts.FieldToken: token.ValueString(),
+	}
+	for k, v := range map[string]types.String{
+		consts.FieldPrimaryAPIAddr: plan.PrimaryAPIAddr,
+		consts.FieldCAFile:         plan.CAFile,
+		consts.FieldCAPath:         plan.CAPath,
+	} {
+		if v.ValueString() != "" {
+			data[k] = v.ValueString()
+		}
+	}
+
+	enablePath := replicationPath(replicationType, replicationModeSecondary, "enable")
+	tflog.Debug(ctx, "Activating replication secondary", map[string]any{consts.FieldPath: enablePath})
+	if _, err := cli.Logical().WriteWithContext(ctx, enablePath, data); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	// The secondary is only usable once it streams the WALs of the primary.
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, r.Meta(), plan.Cluster.ValueString())
+	status, err := waitForReplication(ctx, cli, maxRetries, replicationType, func(status *replicationStatusAPIModel) bool {
+		return status.Mode == replicationModeSecondary && status.State == replicationStateStreamWALs
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	plan.ClusterID = types.StringValue(status.ClusterID)
+	plan.SecondaryID = types.StringValue(status.SecondaryID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReplicationSecondaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReplicationSecondaryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, r.Meta(), state.Cluster.ValueString())
+	status, err := readReplicationStatus(ctx, cli, maxRetries, state.ReplicationType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if status.Mode != replicationModeSecondary {
+		tflog.Warn(ctx, "Replication secondary is not enabled, removing from state", map[string]any{
+			consts.FieldReplicationType: state.ReplicationType.ValueString(),
+			consts.FieldMode:            status.Mode,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ClusterID = types.StringValue(status.ClusterID)
+	state.SecondaryID = types.StringValue(status.SecondaryID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReplicationSecondaryResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update not supported", "Replication secondaries cannot be updated, they must be replaced")
+}
+
+func (r *ReplicationSecondaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReplicationSecondaryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), state.Namespace.ValueString(), state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	replicationType := state.ReplicationType.ValueString()
+	disablePath := replicationPath(replicationType, replicationModeSecondary, "disable")
+	tflog.Debug(ctx, "Disabling replication secondary", map[string]any{consts.FieldPath: disablePath})
+	if _, err := cli.Logical().WriteWithContext(ctx, disablePath, nil); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+		return
+	}
+
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, r.Meta(), state.Cluster.ValueString())
+	if _, err := waitForReplication(ctx, cli, maxRetries, replicationType, replicationModeIs(replicationModeDisabled)); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+func (r *ReplicationSecondaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == replicationTypeDR {
+		resp.Diagnostics.AddError("Invalid import ID", errReplicationSecondaryDR)
+		return
+	}
+	if req.ID != replicationTypePerformance {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be the replication type %q, got: %q", replicationTypePerformance, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldReplicationType), req.ID)...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+
+	base.ImportClusterFromEnv(ctx, resp)
+}
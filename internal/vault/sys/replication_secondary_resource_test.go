@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// envVarTFAccReplicationSecondaryAddr is the address of a second test cluster
+// that is activated as a performance secondary of the test cluster. Its data
+// is deleted. Both clusters must accept the provider's token until the
+// secondary is activated, e.g. dev servers with the same root token ID.
+const envVarTFAccReplicationSecondaryAddr = "TF_ACC_REPLICATION_SECONDARY_ADDR"
+
+func TestAccReplicationSecondary(t *testing.T) {
+	var secondaryAddr string
+	resourceName := "vault_replication_secondary.test"
+	dataSourceName := "data.vault_replication_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestEntPreCheck(t)
+			testutil.SkipTestEnvUnset(t, envVarTFAccReplication)
+			secondaryAddr = testutil.SkipTestEnvUnset(t, envVarTFAccReplicationSecondaryAddr)[0]
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSecondaryConfig(secondaryAddr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldReplicationType, "performance"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecondaryID, "tf-test-secondary"),
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldClusterID,
+						"vault_replication_primary.test", consts.FieldClusterID),
+					resource.TestCheckNoResourceAttr(resourceName, consts.FieldTokenWO),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldMode, "secondary"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldState, "stream-wals"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldSecondaryID, "tf-test-secondary"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        "performance",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: consts.FieldReplicationType,
+			},
+		},
+	})
+}
+
+func TestAccReplicationSecondary_dr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "vault_replication_secondary" "test" {
+  replication_type = "dr"
+  token_wo         = "not-a-token"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Unsupported replication type`),
+			},
+		},
+	})
+}
+
+func testAccReplicationSecondaryConfig(secondaryAddr string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_primary" "test" {
+  replication_type = "performance"
+}
+
+ephemeral "vault_replication_secondary_token" "test" {
+  replication_type = "performance"
+  id               = "tf-test-secondary"
+  mount_id         = vault_replication_primary.test.cluster_id
+}
+
+provider "vault" {
+  alias   = "secondary"
+  address = "%s"
+  token   = "%s"
+}
+
+resource "vault_replication_secondary" "test" {
+  provider         = vault.secondary
+  replication_type = "performance"
+  token_wo         = ephemeral.vault_replication_secondary_token.test.token
+}
+
+data "vault_replication_status" "test" {
+  provider         = vault.secondary
+  replication_type = "performance"
+
+  depends_on = [vault_replication_secondary.test]
+}
+`, secondaryAddr, os.Getenv(api.EnvVaultToken))
+}
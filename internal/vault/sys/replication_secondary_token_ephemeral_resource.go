@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ ephemeral.EphemeralResource = &ReplicationSecondaryTokenEphemeralResource{}
+
+// NewReplicationSecondaryTokenEphemeralResource returns the implementation
+// for this resource to be imported by the Terraform Plugin Framework provider
+var NewReplicationSecondaryTokenEphemeralResource = func() ephemeral.EphemeralResource {
+	return &ReplicationSecondaryTokenEphemeralResource{}
+}
+
+// ReplicationSecondaryTokenEphemeralResource issues a secondary activation
+// token on a performance or DR primary.
+type ReplicationSecondaryTokenEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// ReplicationSecondaryTokenModel describes the Terraform resource data model
+// to match the resource schema.
+type ReplicationSecondaryTokenModel struct {
+	base.BaseModelEphemeral
+
+	ReplicationType    types.String `tfsdk:"replication_type"`
+	ID                 types.String `tfsdk:"id"`
+	TTL                types.String `tfsdk:"ttl"`
+	SecondaryPublicKey types.String `tfsdk:"secondary_public_key"`
+	Token              types.String `tfsdk:"token"`
+	Accessor           types.String `tfsdk:"accessor"`
+}
+
+func (r *ReplicationSecondaryTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldReplicationType: schema.StringAttribute{
+				MarkdownDescription: "The type of replication of the primary, `performance` or `dr`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(replicationTypes...),
+				},
+			},
+			consts.FieldID: schema.StringAttribute{
+				MarkdownDescription: "An identifier for the secondary, used to manage it on the primary, " +
+					"e.g. to set its paths filter.",
+				Required: true,
+			},
+			consts.FieldTTL: schema.StringAttribute{
+				MarkdownDescription: "The TTL of the activation token, e.g. `30m`. Defaults to Vault's default of `30m`.",
+				Optional:            true,
+			},
+			consts.FieldSecondaryPublicKey: schema.StringAttribute{
+				MarkdownDescription: "The public key of the secondary, from its " +
+					"`sys/replication/<type>/secondary/generate-public-key` endpoint. If set, the activation " +
+					"token is encrypted with this key instead of being a response-wrapping token.",
+				Optional: true,
+			},
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The secondary activation token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldAccessor: schema.StringAttribute{
+				MarkdownDescription: "The accessor of the activation token, when it is a response-wrapping token.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Issues a secondary activation token on a performance or DR primary.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *ReplicationSecondaryTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_secondary_token"
+}
+
+func (r *ReplicationSecondaryTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ReplicationSecondaryTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	body := map[string]interface{}{
+		consts.FieldID: data.ID.ValueString(),
+	}
+	if v := data.TTL.ValueString(); v != "" {
+		body[consts.FieldTTL] = v
+	}
+	if v := data.SecondaryPublicKey.ValueString(); v != "" {
+		body[consts.FieldSecondaryPublicKey] = v
+	}
+
+	tokenPath := replicationPath(data.ReplicationType.ValueString(), replicationModePrimary, "secondary-token")
+	tflog.Debug(ctx, "Issuing secondary activation token", map[string]any{
+		consts.FieldPath: tokenPath,
+		consts.FieldID:   data.ID.ValueString(),
+	})
+	secret, err := c.Logical().WriteWithContext(ctx, tokenPath, body)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	token, accessor, ok := secondaryActivationToken(secret)
+	if !ok {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+	data.Accessor = types.StringNull()
+	if accessor != "" {
+		data.Accessor = types.StringValue(accessor)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// secondaryActivationToken returns the activation token of the response, and
+// its accessor if it is a response-wrapping token. The activation token is
+// returned as a response-wrapping token, unless it is encrypted with the
+// public key of the secondary.
+func secondaryActivationToken(secret *api.Secret) (string, string, bool) {
+	if secret.WrapInfo != nil {
+		return secret.WrapInfo.Token, secret.WrapInfo.Accessor, true
+	}
+
+	token, ok := secret.Data[consts.FieldToken].(string)
+	return token, "", ok && token != ""
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccReplicationSecondaryTokenEphemeralResource(t *testing.T) {
+	nonEmpty := regexp.MustCompile(`^.+$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestEntPreCheck(t)
+			testutil.SkipTestEnvUnset(t, envVarTFAccReplication)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSecondaryTokenConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					// the activation token is a response-wrapping token
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("token"),
+						knownvalue.StringRegexp(nonEmpty)),
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("accessor"),
+						knownvalue.StringRegexp(nonEmpty)),
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("id"),
+						knownvalue.StringExact("tf-test-token-secondary")),
+				},
+			},
+		},
+	})
+}
+
+const testAccReplicationSecondaryTokenConfig = `
+resource "vault_replication_primary" "test" {
+  replication_type = "performance"
+}
+
+ephemeral "vault_replication_secondary_token" "test" {
+  replication_type = "performance"
+  id               = "tf-test-token-secondary"
+  ttl              = "5m"
+  mount_id         = vault_replication_primary.test.cluster_id
+}
+
+provider "echo" {
+  data = ephemeral.vault_replication_secondary_token.test
+}
+
+resource "echo" "test" {}
+`
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &ReplicationStatusDataSource{}
+
+// NewReplicationStatusDataSource returns the implementation for this data source
+func NewReplicationStatusDataSource() datasource.DataSource {
+	return &ReplicationStatusDataSource{}
+}
+
+// ReplicationStatusDataSource implements the methods that define this data source
+type ReplicationStatusDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// ReplicationStatusModel describes the Terraform data source data model
+type ReplicationStatusModel struct {
+	base.BaseModel
+
+	ReplicationType          types.String                      `tfsdk:"replication_type"`
+	Mode                     types.String                      `tfsdk:"mode"`
+	State                    types.String                      `tfsdk:"state"`
+	ClusterID                types.String                      `tfsdk:"cluster_id"`
+	PrimaryClusterAddr       types.String                      `tfsdk:"primary_cluster_addr"`
+	ConnectionState          types.String                      `tfsdk:"connection_state"`
+	SecondaryID              types.String                      `tfsdk:"secondary_id"`
+	KnownSecondaries         []types.String                    `tfsdk:"known_secondaries"`
+	KnownPrimaryClusterAddrs []types.String                    `tfsdk:"known_primary_cluster_addrs"`
+	LastWAL                  types.Int64                       `tfsdk:"last_wal"`
+	LastRemoteWAL            types.Int64                       `tfsdk:"last_remote_wal"`
+	MerkleRoot               types.String                      `tfsdk:"merkle_root"`
+	Secondaries              []ReplicationStatusSecondaryModel `tfsdk:"secondaries"`
+}
+
+// ReplicationStatusSecondaryModel describes a secondary known by a primary
+type ReplicationStatusSecondaryModel struct {
+	NodeID           types.String `tfsdk:"node_id"`
+	APIAddress       types.String `tfsdk:"api_address"`
+	ClusterAddress   types.String `tfsdk:"cluster_address"`
+	ConnectionStatus types.String `tfsdk:"connection_status"`
+	LastHeartbeat    types.String `tfsdk:"last_heartbeat"`
+}
+
+func (d *ReplicationStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_status"
+}
+
+func (d *ReplicationStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldReplicationType: schema.StringAttribute{
+				MarkdownDescription: "The type of replication, `performance` or `dr`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(replicationTypes...),
+				},
+			},
+			consts.FieldMode: schema.StringAttribute{
+				MarkdownDescription: "The replication mode of the cluster, `primary`, `secondary` or `disabled`.",
+				Computed:            true,
+			},
+			consts.FieldState: schema.StringAttribute{
+				MarkdownDescription: "The replication state of the cluster, e.g. `running` for a primary or " +
+					"`stream-wals` for a secondary in sync with its primary.",
+				Computed: true,
+			},
+			consts.FieldClusterID: schema.StringAttribute{
+				MarkdownDescription: "The ID of the replicated cluster.",
+				Computed:            true,
+			},
+			consts.FieldPrimaryClusterAddr: schema.StringAttribute{
+				MarkdownDescription: "The cluster address of the primary.",
+				Computed:            true,
+			},
+			consts.FieldConnectionState: schema.StringAttribute{
+				MarkdownDescription: "The state of the connection of a secondary to its primary, e.g. `ready`.",
+				Computed:            true,
+			},
+			consts.FieldSecondaryID: schema.StringAttribute{
+				MarkdownDescription: "The ID of a secondary, as known by its primary.",
+				Computed:            true,
+			},
+			consts.FieldKnownSecondaries: schema.ListAttribute{
+				MarkdownDescription: "The IDs of the secondaries of a primary.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			consts.FieldKnownPrimaryClusterAddrs: schema.ListAttribute{
+				MarkdownDescription: "The cluster addresses of the nodes of the primary of a secondary.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			consts.FieldLastWAL: schema.Int64Attribute{
+				MarkdownDescription: "The index of the last WAL of the cluster.",
+				Computed:            true,
+			},
+			consts.FieldLastRemoteWAL: schema.Int64Attribute{
+				MarkdownDescription: "The index of the last WAL of the primary received by a secondary.",
+				Computed:            true,
+			},
+			consts.FieldMerkleRoot: schema.StringAttribute{
+				MarkdownDescription: "The merkle root of the replicated data.",
+				Computed:            true,
+			},
+			consts.FieldSecondaries: schema.ListAttribute{
+				MarkdownDescription: "The secondaries connected to a primary.",
+				Computed:            true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						consts.FieldNodeID:           types.StringType,
+						consts.FieldAPIAddress:       types.StringType,
+						consts.FieldClusterAddress:   types.StringType,
+						consts.FieldConnectionStatus: types.StringType,
+						consts.FieldLastHeartbeat:    types.StringType,
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Reads the performance or DR replication status of the cluster.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *ReplicationStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReplicationStatusModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	maxRetries := client.GetMaxHTTPRetriesCCC(ctx, d.Meta(), data.Cluster.ValueString())
+	status, err := readReplicationStatus(ctx, cli, maxRetries, data.ReplicationType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	data.Mode = types.StringValue(status.Mode)
+	data.State = types.StringValue(status.State)
+	data.ClusterID = types.StringValue(status.ClusterID)
+	data.PrimaryClusterAddr = types.StringValue(status.PrimaryClusterAddr)
+	data.ConnectionState = types.StringValue(status.ConnectionState)
+	data.SecondaryID = types.StringValue(status.SecondaryID)
+	data.LastWAL = types.Int64Value(status.LastWAL)
+	data.LastRemoteWAL = types.Int64Value(status.LastRemoteWAL)
+	data.MerkleRoot = types.StringValue(status.MerkleRoot)
+
+	data.KnownSecondaries = make([]types.String, 0, len(status.KnownSecondaries))
+	for _, v := range status.KnownSecondaries {
+		data.KnownSecondaries = append(data.KnownSecondaries, types.StringValue(v))
+	}
+
+	data.KnownPrimaryClusterAddrs = make([]types.String, 0, len(status.KnownPrimaryClusterAddrs))
+	for _, v := range status.KnownPrimaryClusterAddrs {
+		data.KnownPrimaryClusterAddrs = append(data.KnownPrimaryClusterAddrs, types.StringValue(v))
+	}
+
+	data.Secondaries = make([]ReplicationStatusSecondaryModel, 0, len(status.Secondaries))
+	for _, s := range status.Secondaries {
+		data.Secondaries = append(data.Secondaries, ReplicationStatusSecondaryModel{
+			NodeID:           types.StringValue(s.NodeID),
+			APIAddress:       types.StringValue(s.APIAddress),
+			ClusterAddress:   types.StringValue(s.ClusterAddress),
+			ConnectionStatus: types.StringValue(s.ConnectionStatus),
+			LastHeartbeat:    types.StringValue(s.LastHeartbeat),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
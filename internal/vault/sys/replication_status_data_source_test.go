@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+// TestAccReplicationStatusDataSource reads the DR replication status, which
+// is disabled on the test cluster. The status of a primary is checked by
+// TestAccReplicationPrimary.
+func TestAccReplicationStatusDataSource(t *testing.T) {
+	dataSourceName := "data.vault_replication_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestEntPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_replication_status" "test" {
+  replication_type = "dr"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldReplicationType, "dr"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldMode, "disabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "known_secondaries.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "secondaries.#", "0"),
+				),
+			},
+		},
+	})
+}
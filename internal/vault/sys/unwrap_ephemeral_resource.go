@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// errWrappingTokenInvalid is the error returned by Vault for a wrapping token
+// that was already unwrapped or has expired.
+const errWrappingTokenInvalid = "wrapping token is not valid or does not exist"
+
+var (
+	_ ephemeral.EphemeralResource          = &UnwrapEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose = &UnwrapEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew = &UnwrapEphemeralResource{}
+)
+
+// NewUnwrapEphemeralResource returns the implementation for this resource to
+// be imported by the Terraform Plugin Framework provider
+var NewUnwrapEphemeralResource = func() ephemeral.EphemeralResource {
+	return &UnwrapEphemeralResource{}
+}
+
+// UnwrapEphemeralResource unwraps a response-wrapping token. The lease of the
+// unwrapped secret, if any, is managed like the leases of other secrets.
+type UnwrapEphemeralResource struct {
+	base.EphemeralResourceWithLease
+}
+
+// UnwrapModel describes the Terraform resource data model to match the
+// resource schema.
+type UnwrapModel struct {
+	base.BaseModelEphemeral
+
+	Token          types.String `tfsdk:"token"`
+	Data           types.Map    `tfsdk:"data"`
+	DataJSON       types.String `tfsdk:"data_json"`
+	ClientToken    types.String `tfsdk:"client_token"`
+	Accessor       types.String `tfsdk:"accessor"`
+	LeaseID        types.String `tfsdk:"lease_id"`
+	LeaseDuration  types.Int64  `tfsdk:"lease_duration"`
+	LeaseRenewable types.Bool   `tfsdk:"lease_renewable"`
+}
+
+func (r *UnwrapEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The response-wrapping token to unwrap. A wrapping token can only be unwrapped once.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			consts.FieldData: schema.MapAttribute{
+				MarkdownDescription: "The unwrapped secret data. Non-string values are JSON encoded.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldDataJSON: schema.StringAttribute{
+				MarkdownDescription: "The unwrapped secret data, JSON encoded.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldClientToken: schema.StringAttribute{
+				MarkdownDescription: "The unwrapped token, when the wrapped response is a token, e.g. " +
+					"from the `vault_token` ephemeral resource.",
+				Computed:  true,
+				Sensitive: true,
+			},
+			consts.FieldAccessor: schema.StringAttribute{
+				MarkdownDescription: "The accessor of the unwrapped token.",
+				Computed:            true,
+			},
+			consts.FieldLeaseID: schema.StringAttribute{
+				MarkdownDescription: "Lease identifier of the unwrapped secret.",
+				Computed:            true,
+			},
+			consts.FieldLeaseDuration: schema.Int64Attribute{
+				MarkdownDescription: "Lease duration of the unwrapped secret, in seconds.",
+				Computed:            true,
+			},
+			consts.FieldLeaseRenewable: schema.BoolAttribute{
+				MarkdownDescription: "True if the duration of the lease can be extended through renewal.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Unwraps a response-wrapping token.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *UnwrapEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unwrap"
+}
+
+func (r *UnwrapEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data UnwrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	tflog.Debug(ctx, "Unwrapping response-wrapping token")
+	secret, err := c.Logical().UnwrapWithContext(ctx, data.Token.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), errWrappingTokenInvalid) {
+			resp.Diagnostics.AddAttributeError(path.Root(consts.FieldToken), "Invalid response-wrapping token",
+				"The token was already unwrapped, or has expired. Ephemeral resources are opened during both "+
+					"plan and apply, so a token that does not change between them, e.g. from a variable, is "+
+					"unwrapped during plan and can no longer be unwrapped during apply. Use a token that is "+
+					"generated during the same phase, e.g. by an ephemeral resource with `wrap_ttl` set.\n\n"+
+					"HTTP Error: "+err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	dataMap := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		if s, ok := v.(string); ok {
+			dataMap[k] = s
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			resp.Diagnostics.AddError("Error marshalling secret value", err.Error())
+			return
+		}
+		dataMap[k] = string(b)
+	}
+
+	m, diags := types.MapValueFrom(ctx, types.StringType, dataMap)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Data = m
+
+	b, err := json.Marshal(secret.Data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling data to JSON", err.Error())
+		return
+	}
+	data.DataJSON = types.StringValue(string(b))
+
+	if secret.Auth != nil {
+		data.ClientToken = types.StringValue(secret.Auth.ClientToken)
+		data.Accessor = types.StringValue(secret.Auth.Accessor)
+	}
+
+	data.LeaseID = types.StringValue(secret.LeaseID)
+	data.LeaseDuration = types.Int64Value(int64(secret.LeaseDuration))
+	data.LeaseRenewable = types.BoolValue(secret.Renewable)
+
+	// Store the lease for its renewal, and its revocation in Close
+	r.SetLease(ctx, resp, secret, data.Namespace.ValueString(), data.Cluster.ValueString())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccUnwrapEphemeralResource(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("role")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUnwrapEphemeralResourceConfig(backend, role),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.unwrap", tfjsonpath.New("data").AtMapKey(consts.FieldData).AtMapKey(consts.FieldSecretID),
+						knownvalue.StringRegexp(regexp.MustCompile("^[a-f0-9-]+$"))),
+					statecheck.ExpectKnownValue("echo.unwrap", tfjsonpath.New("data").AtMapKey(consts.FieldClientToken), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+// TestAccUnwrapEphemeralResource_stableToken checks that a token that does not
+// change between plan and apply is unwrapped during plan, and reported as
+// invalid during apply.
+func TestAccUnwrapEphemeralResource_stableToken(t *testing.T) {
+	acctestutil.SkipTestAcc(t)
+
+	c, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := c.Logical().Write("sys/wrapping/wrap", map[string]interface{}{
+		"foo": "bar",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret == nil || secret.WrapInfo == nil {
+		t.Fatal("expected a wrapped response")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+ephemeral "vault_unwrap" "stable" {
+  token = %q
+}
+
+provider "echo" {
+  data = ephemeral.vault_unwrap.stable
+}
+
+resource "echo" "unwrap" {}
+`, secret.WrapInfo.Token),
+				ExpectError: regexp.MustCompile(`Invalid response-wrapping token`),
+			},
+		},
+	})
+}
+
+func testAccUnwrapEphemeralResourceConfig(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%s"
+}
+
+ephemeral "vault_approle_auth_backend_role_secret_id" "wrapped" {
+  backend   = vault_auth_backend.approle.path
+  role_name = vault_approle_auth_backend_role.role.role_name
+  mount_id  = vault_approle_auth_backend_role.role.id
+  wrap_ttl  = "5m"
+}
+
+ephemeral "vault_unwrap" "secret_id" {
+  token = ephemeral.vault_approle_auth_backend_role_secret_id.wrapped.wrapping_token
+}
+
+provider "echo" {
+  data = ephemeral.vault_unwrap.secret_id
+}
+
+resource "echo" "unwrap" {}
+`, backend, role)
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+// Ensure the implementation satisfies the datasource.DataSource interface
+var _ datasource.DataSource = &WrappingLookupDataSource{}
+
+// NewWrappingLookupDataSource returns the implementation for this data source
+func NewWrappingLookupDataSource() datasource.DataSource {
+	return &WrappingLookupDataSource{}
+}
+
+// WrappingLookupDataSource implements the methods that define this data source
+type WrappingLookupDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// WrappingLookupModel describes the Terraform data source data model
+type WrappingLookupModel struct {
+	base.BaseModel
+
+	Token        types.String `tfsdk:"token"`
+	CreationPath types.String `tfsdk:"creation_path"`
+	CreationTime types.String `tfsdk:"creation_time"`
+	CreationTTL  types.Int64  `tfsdk:"creation_ttl"`
+}
+
+// WrappingLookupAPIModel describes the Vault API data model.
+type WrappingLookupAPIModel struct {
+	CreationPath string `json:"creation_path"`
+	CreationTime string `json:"creation_time"`
+	CreationTTL  int64  `json:"creation_ttl"`
+}
+
+func (d *WrappingLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wrapping_lookup"
+}
+
+func (d *WrappingLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The response-wrapping token to look up. The token is not unwrapped.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			consts.FieldCreationPath: schema.StringAttribute{
+				MarkdownDescription: "The path of the request whose response was wrapped.",
+				Computed:            true,
+			},
+			consts.FieldCreationTime: schema.StringAttribute{
+				MarkdownDescription: "The time at which the wrapping token was created, in RFC3339 format.",
+				Computed:            true,
+			},
+			consts.FieldCreationTTL: schema.Int64Attribute{
+				MarkdownDescription: "The TTL of the wrapping token, in seconds.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Looks up the properties of a response-wrapping token without unwrapping it.",
+	}
+
+	base.MustAddBaseDataSourceSchema(&resp.Schema)
+}
+
+func (d *WrappingLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WrappingLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString(), data.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	secret, err := cli.Logical().WriteWithContext(ctx, "sys/wrapping/lookup", map[string]interface{}{
+		consts.FieldToken: data.Token.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var apiResp WrappingLookupAPIModel
+	if err := model.ToAPIModel(secret.Data, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.CreationPath = types.StringValue(apiResp.CreationPath)
+	data.CreationTime = types.StringValue(apiResp.CreationTime)
+	data.CreationTTL = types.Int64Value(apiResp.CreationTTL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
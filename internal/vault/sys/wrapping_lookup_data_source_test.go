@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccWrappingLookupDataSource(t *testing.T) {
+	acctestutil.SkipTestAcc(t)
+	acctestutil.TestAccPreCheck(t)
+
+	token := testAccWrappedToken(t)
+	dataSourceName := "data.vault_wrapping_lookup.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "vault_wrapping_lookup" "test" {
+  token = "%s"
+}
+`, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldCreationPath, "auth/token/create"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldCreationTTL, "300"),
+					resource.TestCheckResourceAttrSet(dataSourceName, consts.FieldCreationTime),
+				),
+			},
+		},
+	})
+}
+
+// testAccWrappedToken returns the wrapping token of a new token.
+func testAccWrappedToken(t *testing.T) string {
+	t.Helper()
+
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.SetWrappingLookupFunc(func(_, _ string) string {
+		return "5m"
+	})
+	secret, err := client.Auth().Token().Create(&api.TokenCreateRequest{
+		TTL: "10m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret == nil || secret.WrapInfo == nil {
+		t.Fatal("expected a wrapped response")
+	}
+
+	return secret.WrapInfo.Token
+}
@@ -173,6 +173,36 @@ func SliceRemoveIfPresent(list []interface{}, search interface{}) []interface{}
 //   - endpoint = "/transform/role/{name}"
 //   - parameters will include path parameters
 func ParsePath(userSuppliedPath, endpoint string, d *schema.ResourceData) string {
+	return parsePath(userSuppliedPath, endpoint, func(field string) (string, bool) {
+		valRaw, ok := d.GetOk(field)
+		if !ok {
+			return "", false
+		}
+		// All path parameters must be strings, so it's safe to
+		// assume here.
+		return valRaw.(string), true
+	})
+}
+
+// ParsePathFromParameters is like ParsePath, but reads path parameters from
+// the given map rather than from the ResourceData. It is intended for
+// resources built with the Terraform Plugin Framework.
+//
+// Example data:
+//   - userSuppliedPath = "transform"
+//   - endpoint = "/transform/role/{name}"
+//   - params = map[string]string{"name": "foo"}
+func ParsePathFromParameters(userSuppliedPath, endpoint string, params map[string]string) string {
+	return parsePath(userSuppliedPath, endpoint, func(field string) (string, bool) {
+		val, ok := params[field]
+		if !ok || val == "" {
+			return "", false
+		}
+		return val, true
+	})
+}
+
+func parsePath(userSuppliedPath, endpoint string, lookup func(string) (string, bool)) string {
 	fields := strings.Split(endpoint, "/")
 	if fields[0] == "" {
 		// There was a leading slash that should be trimmed.
@@ -202,13 +232,10 @@ func ParsePath(userSuppliedPath, endpoint string, d *schema.ResourceData) string
 		return c == '{' || c == '}'
 	})
 	for _, field := range fields {
-		valRaw, ok := d.GetOk(field)
+		val, ok := lookup(field)
 		if !ok {
 			continue
 		}
-		// All path parameters must be strings, so it's safe to
-		// assume here.
-		val := valRaw.(string)
 		recomprised = strings.Replace(recomprised, fmt.Sprintf("{%s}", field), val, -1)
 	}
 	return recomprised
@@ -205,6 +205,46 @@ func TestParsePath(t *testing.T) {
 	}
 }
 
+func TestParsePathFromParameters(t *testing.T) {
+	testCases := []struct {
+		inputUserSuppliedPath, inputEndpoint string
+		inputParams                          map[string]string
+		expected                             string
+	}{
+		{
+			inputUserSuppliedPath: "my/transform/hello",
+			inputEndpoint:         "/transform/role/{name}",
+			inputParams: map[string]string{
+				"name": "foo",
+			},
+			expected: "/my/transform/hello/role/foo",
+		},
+		{
+			inputUserSuppliedPath: "jwt-1914071788362821795",
+			inputEndpoint:         "/auth/jwt/config",
+			expected:              "/auth/jwt-1914071788362821795/config",
+		},
+		{
+			inputUserSuppliedPath: "accounting-transit",
+			inputEndpoint:         "/transit/export/{type}/{name}/{version}",
+			inputParams: map[string]string{
+				"version": "1",
+				"type":    "encryption-key",
+				"name":    "my-key",
+			},
+			expected: "/accounting-transit/export/encryption-key/my-key/1",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.inputUserSuppliedPath, func(t *testing.T) {
+			actual := ParsePathFromParameters(testCase.inputUserSuppliedPath, testCase.inputEndpoint, testCase.inputParams)
+			if actual != testCase.expected {
+				t.Fatalf("expected %q, received %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
 func TestPathParameters(t *testing.T) {
 	testCases := []struct {
 		endpoint, vaultPath string
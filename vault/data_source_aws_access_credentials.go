@@ -49,7 +49,7 @@ const (
 )
 
 func awsAccessCredentialsDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(awsAccessCredentialsDataSourceRead),
 
 		Schema: map[string]*schema.Schema{
@@ -130,7 +130,7 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 				Description: "User specified Time-To-Live for the STS token. Uses the Role defined default_sts_ttl when not specified",
 			},
 		},
-	}
+	})
 }
 
 func awsAccessCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -139,6 +139,11 @@ func awsAccessCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(e)
 	}
 
+	client, err := provider.GetWrappingClient(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	backend := d.Get("backend").(string)
 	credType := d.Get("type").(string)
 	role := d.Get("role").(string)
@@ -165,6 +170,11 @@ func awsAccessCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("no role found at path %q", path))
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	accessKey := secret.Data["access_key"].(string)
 	secretKey := secret.Data["secret_key"].(string)
 	var securityToken string
@@ -17,7 +17,7 @@ import (
 const awsStaticCredsAffix = "static-creds"
 
 func awsStaticCredDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(awsStaticCredentialsDataSourceRead),
 		Schema: map[string]*schema.Schema{
 			// backend is deprecated, but the other AWS resource types use it, and predate the deprecation.
@@ -46,7 +46,7 @@ func awsStaticCredDataSource() *schema.Resource {
 				Sensitive:   true,
 			},
 		},
-	}
+	})
 }
 
 func awsStaticCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -55,6 +55,11 @@ func awsStaticCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	client, err = provider.GetWrappingClient(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	backend := d.Get(consts.FieldBackend).(string)
 	role := d.Get(consts.FieldName).(string)
 	fullPath := fmt.Sprintf("%s/%s/%s", backend, awsStaticCredsAffix, role)
@@ -68,6 +73,11 @@ func awsStaticCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("no role found at %q", fullPath))
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(fullPath)
 
 	if err := d.Set(consts.FieldAccessKey, secret.Data[consts.FieldAccessKey]); err != nil {
@@ -40,7 +40,7 @@ var azureCloudConfigMap = map[string]cloud.Configuration{
 }
 
 func azureAccessCredentialsDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(azureAccessCredentialsDataSourceRead),
 
 		Schema: map[string]*schema.Schema{
@@ -129,7 +129,7 @@ Defaults to the Azure Public Cloud.
 Some possible values: AzurePublicCloud, AzureUSGovernmentCloud`,
 			},
 		},
-	}
+	})
 }
 
 func azureAccessCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -138,6 +138,11 @@ func azureAccessCredentialsDataSourceRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(e)
 	}
 
+	client, err := provider.GetWrappingClient(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	backend := d.Get("backend").(string)
 	role := d.Get("role").(string)
 
@@ -153,6 +158,11 @@ func azureAccessCredentialsDataSourceRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("no role found at credsPath %q", credsPath)
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	clientID := secret.Data["client_id"].(string)
 	clientSecret := secret.Data["client_secret"].(string)
 
@@ -16,7 +16,7 @@ import (
 )
 
 func genericSecretDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		Read: provider.ReadWrapper(genericSecretDataSourceRead),
 
 		Schema: map[string]*schema.Schema{
@@ -79,7 +79,7 @@ func genericSecretDataSource() *schema.Resource {
 				Description: "True if the duration of this lease can be extended through renewal.",
 			},
 		},
-	}
+	})
 }
 
 func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error {
@@ -88,6 +88,11 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 		return e
 	}
 
+	client, e = provider.GetWrappingClient(d, client)
+	if e != nil {
+		return e
+	}
+
 	path := d.Get("path").(string)
 
 	secretVersion := d.Get("version").(int)
@@ -101,6 +106,11 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("no secret found at %q", path)
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return err
+	}
+
 	d.SetId(path)
 
 	// Ignoring error because this value came from JSON in the
@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
 
@@ -52,6 +54,44 @@ func TestDataSourceGenericSecret_v2(t *testing.T) {
 	})
 }
 
+func TestDataSourceGenericSecret_wrapped(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-acctest-kv/")
+	path := acctest.RandomWithPrefix("foo")
+	resourceName := "data.vault_generic_secret.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+  options = {
+    "version" = "2"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path      = "${vault_mount.test.path}/%s"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+data "vault_generic_secret" "test" {
+  path     = vault_generic_secret.test.path
+  wrap_ttl = "5m"
+}
+`, mount, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, consts.FieldWrappingToken, regexp.MustCompile(`^hv?s\.`)),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldWrappingAccessor),
+					resource.TestCheckNoResourceAttr(resourceName, "data.zip"),
+				),
+			},
+		},
+	})
+}
+
 func testDataSourceV2Secret_config(mount, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
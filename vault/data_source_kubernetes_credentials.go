@@ -15,7 +15,7 @@ import (
 )
 
 func kubernetesServiceAccountTokenDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(readKubernetesServiceAccountToken),
 		Schema: map[string]*schema.Schema{
 			consts.FieldBackend: {
@@ -80,7 +80,7 @@ func kubernetesServiceAccountTokenDataSource() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	})
 }
 
 func readKubernetesServiceAccountToken(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -89,6 +89,11 @@ func readKubernetesServiceAccountToken(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	client, err = provider.GetWrappingClient(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	data := make(map[string]interface{})
 	inputFields := []string{
 		consts.FieldKubernetesNamespace,
@@ -110,6 +115,11 @@ func readKubernetesServiceAccountToken(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("no role found at %q", path)
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(secret.LeaseID)
 	dataFields := []string{
 		consts.FieldServiceAccountName,
@@ -16,7 +16,7 @@ import (
 )
 
 func kvSecretDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(kvSecretDataSourceRead),
 
 		Schema: map[string]*schema.Schema{
@@ -54,7 +54,7 @@ func kvSecretDataSource() *schema.Resource {
 				Description: "True if the duration of this lease can be extended through renewal.",
 			},
 		},
-	}
+	})
 }
 
 func kvSecretDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -63,6 +63,11 @@ func kvSecretDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(e)
 	}
 
+	client, e = provider.GetWrappingClient(d, client)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
 	path := d.Get(consts.FieldPath).(string)
 
 	if err := d.Set(consts.FieldPath, path); err != nil {
@@ -79,6 +84,11 @@ func kvSecretDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("no secret found at %q", path)
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	jsonData, err := json.Marshal(secret.Data)
 	if err != nil {
 		return diag.Errorf("error marshaling JSON for %q: %s", path, err)
@@ -17,7 +17,7 @@ import (
 )
 
 func ldapDynamicCredDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(readLDAPDynamicCreds),
 		Schema: map[string]*schema.Schema{
 			consts.FieldMount: {
@@ -64,7 +64,7 @@ func ldapDynamicCredDataSource() *schema.Resource {
 				Description: "Name of the dynamic role.",
 			},
 		},
-	}
+	})
 }
 
 func readLDAPDynamicCreds(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -73,6 +73,11 @@ func readLDAPDynamicCreds(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	client, err = provider.GetWrappingClient(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	mount := d.Get(consts.FieldMount).(string)
 	role := d.Get(consts.FieldRoleName).(string)
 	fullPath := fmt.Sprintf("%s/creds/%s", mount, role)
@@ -86,6 +91,11 @@ func readLDAPDynamicCreds(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(fmt.Errorf("no role found at %q", fullPath))
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	response, err := parseLDAPDynamicCredSecret(secret)
 	if err != nil {
 		return diag.FromErr(err)
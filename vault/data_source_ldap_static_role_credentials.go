@@ -18,7 +18,7 @@ import (
 )
 
 func ldapStaticCredDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		ReadContext: provider.ReadContextWrapper(readLDAPStaticCreds),
 		Schema: map[string]*schema.Schema{
 			consts.FieldMount: {
@@ -75,7 +75,7 @@ func ldapStaticCredDataSource() *schema.Resource {
 				Description: "Whether the credential was rotated during this read (rotate-on-read feature). Requires Vault Enterprise.",
 			},
 		},
-	}
+	})
 }
 
 func readLDAPStaticCreds(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -84,6 +84,11 @@ func readLDAPStaticCreds(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	client, err = provider.GetWrappingClient(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	mount := d.Get(consts.FieldMount).(string)
 	role := d.Get(consts.FieldRoleName).(string)
 	fullPath := fmt.Sprintf("%s/static-cred/%s", mount, role)
@@ -97,6 +102,11 @@ func readLDAPStaticCreds(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(fmt.Errorf("no role found at %q", fullPath))
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return diag.FromErr(err)
+	}
+
 	response, err := parseLDAPStaticCredSecret(secret)
 	if err != nil {
 		return diag.FromErr(err)
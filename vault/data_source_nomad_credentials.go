@@ -13,7 +13,7 @@ import (
 )
 
 func nomadAccessCredentialsDataSource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddWrappingSchema(&schema.Resource{
 		Read: provider.ReadWrapper(readNomadCredsResource),
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -39,7 +39,7 @@ func nomadAccessCredentialsDataSource() *schema.Resource {
 				Sensitive:   true,
 			},
 		},
-	}
+	})
 }
 
 func readNomadCredsResource(d *schema.ResourceData, meta interface{}) error {
@@ -48,6 +48,11 @@ func readNomadCredsResource(d *schema.ResourceData, meta interface{}) error {
 		return e
 	}
 
+	client, err := provider.GetWrappingClient(d, client)
+	if err != nil {
+		return err
+	}
+
 	backend := d.Get("backend").(string)
 	role := d.Get("role").(string)
 	path := fmt.Sprintf("%s/creds/%s", backend, role)
@@ -62,6 +67,11 @@ func readNomadCredsResource(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("no role found at %q", path)
 	}
 
+	// A wrapped response only holds the wrapping token
+	if wrapped, err := provider.SetWrapInfo(d, secret); wrapped || err != nil {
+		return err
+	}
+
 	accessorID := secret.Data["accessor_id"].(string)
 	if accessorID == "" {
 		return fmt.Errorf("accessor_id is not set in response")
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const fieldAssociatedSecrets = "associated_secrets"
+
+func secretsSyncStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(secretsSyncStatusDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the destination.",
+			},
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Type of sync destination.",
+			},
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the secrets of this mount.",
+			},
+			fieldSecretName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only report this secret of the mount.",
+				RequiredWith: []string{consts.FieldMount},
+			},
+			fieldSyncStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Sync status of the destination, the status of its least " +
+					"healthy associated secret.",
+			},
+			fieldUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last update of the associated secrets.",
+			},
+			fieldAssociatedSecrets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sync status of each subkey of the associated secrets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldAccessor: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Accessor of the mount of the secret.",
+						},
+						fieldSecretName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the secret.",
+						},
+						fieldSubkey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subkey of the secret.",
+						},
+						fieldSyncStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Sync status of the subkey.",
+						},
+						fieldUpdatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of the last update of the subkey.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func secretsSyncStatusDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	destName := d.Get(consts.FieldName).(string)
+	typ := d.Get(consts.FieldType).(string)
+
+	log.Printf("[DEBUG] Reading associations of destination %s of type %s", destName, typ)
+	model, err := readSyncAssociations(ctx, client, destName, typ)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := fmt.Sprintf("%s/dest/%s", typ, destName)
+	associations := model.filter("", "")
+	if mount, ok := d.GetOk(consts.FieldMount); ok {
+		accessor, err := getMountAccessor(ctx, d, meta, mount.(string))
+		if err != nil {
+			return diag.Errorf("could not obtain accessor from given mount; err=%s", err)
+		}
+
+		id = fmt.Sprintf("%s/mount/%s", id, mount)
+		associations = model.filter(accessor, "")
+		if secretName, ok := d.GetOk(fieldSecretName); ok {
+			id = fmt.Sprintf("%s/secret/%s", id, secretName)
+			associations = model.filter(accessor, secretName.(string))
+		}
+	}
+
+	secrets := make([]map[string]interface{}, 0, len(associations))
+	for _, v := range associations {
+		secrets = append(secrets, map[string]interface{}{
+			consts.FieldAccessor: v.Accessor,
+			fieldSecretName:      v.SecretName,
+			fieldSubkey:          v.Subkey,
+			fieldSyncStatus:      v.SyncStatus,
+			fieldUpdatedAt:       v.UpdatedAt,
+		})
+	}
+
+	if err := d.Set(fieldAssociatedSecrets, secrets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, updatedAt := syncAssociationsStatus(associations)
+	if err := d.Set(fieldSyncStatus, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(fieldUpdatedAt, updatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceSecretsSyncStatus(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-sync")
+	destName := acctest.RandomWithPrefix("tf-sync-dest")
+	secretName := acctest.RandomWithPrefix("tf-sync-secret")
+
+	dataSourceName := "data.vault_secrets_sync_status.test"
+
+	values := testutil.SkipTestEnvUnset(t,
+		"GITHUB_ACCESS_TOKEN",
+		"GITHUB_REPO_OWNER",
+		"GITHUB_REPO_NAME",
+	)
+
+	accessToken := values[0]
+	repoOwner := values[1]
+	repoName := values[2]
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion116)
+		}, PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSecretsSyncStatusConfig(mount, accessToken, repoOwner, repoName, destName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldName, destName),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldType, ghSyncType),
+					resource.TestCheckResourceAttr(dataSourceName, fieldSecretName, secretName),
+					resource.TestCheckResourceAttr(dataSourceName, fieldSyncStatus, syncStatusSynced),
+					resource.TestCheckResourceAttrSet(dataSourceName, fieldUpdatedAt),
+					resource.TestCheckResourceAttr(dataSourceName, "associated_secrets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "associated_secrets.0.secret_name", secretName),
+					resource.TestCheckResourceAttr(dataSourceName, "associated_secrets.0.sync_status", syncStatusSynced),
+					resource.TestCheckResourceAttrSet(dataSourceName, "associated_secrets.0.accessor"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceSecretsSyncStatusConfig(mount, accessToken, owner, repoName, destName, secretName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "vault_secrets_sync_status" "test" {
+  name        = vault_secrets_sync_association.test.name
+  type        = vault_secrets_sync_association.test.type
+  mount       = vault_secrets_sync_association.test.mount
+  secret_name = vault_secrets_sync_association.test.secret_name
+
+  depends_on = [vault_secrets_sync_association.test]
+}
+`, testSecretsSyncAssociationConfig_ghWaitForSynced(mount, accessToken, owner, repoName, destName, secretName))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func transitCMACDataSource() *schema.Resource {
@@ -84,7 +85,7 @@ func transitCMACDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	payload := map[string]interface{}{}
 
 	if batchInput, ok := d.GetOk(consts.FieldBatchInput); ok {
-		payload[consts.FieldBatchInput], err = convertBatchInput(batchInput, []string{consts.FieldKeyVersion, consts.FieldMACLength})
+		payload[consts.FieldBatchInput], err = util.ConvertBatchInput(batchInput, []string{consts.FieldKeyVersion, consts.FieldMACLength})
 		if err != nil {
 			return err
 		}
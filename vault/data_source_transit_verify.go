@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func transitVerifyDataSource() *schema.Resource {
@@ -131,7 +132,7 @@ func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	payload := map[string]interface{}{}
 
 	if batchInput, ok := d.GetOk(consts.FieldBatchInput); ok {
-		payload[consts.FieldBatchInput], e = convertBatchInput(batchInput, []string{consts.FieldKeyVersion, consts.FieldMACLength})
+		payload[consts.FieldBatchInput], e = util.ConvertBatchInput(batchInput, []string{consts.FieldKeyVersion, consts.FieldMACLength})
 		if e != nil {
 			return e
 		}
@@ -175,7 +176,7 @@ func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	valid, validOK := resp.Data[consts.FieldValid]
 
 	if batchOK {
-		batchResults, err := convertBatchResults(rawBatchResults)
+		batchResults, err := util.ConvertBatchResults(rawBatchResults)
 		if err != nil {
 			return err
 		}
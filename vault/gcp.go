@@ -9,6 +9,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -62,7 +63,7 @@ func gcpSecretBindingHash(v interface{}) int {
 
 func gcpSecretRenderBinding(binding *GCPBinding) string {
 	output := fmt.Sprintf("resource \"%s\" {\n", binding.Resource)
-	output = fmt.Sprintf("%s  roles = %s\n", output, policyRenderListOfStrings(binding.Roles))
+	output = fmt.Sprintf("%s  roles = %s\n", output, policy.RenderListOfStrings(binding.Roles))
 	return fmt.Sprintf("%s}\n", output)
 }
 
@@ -69,6 +69,10 @@ var (
 			Resource:      UpdateSchemaResource(ldapDynamicCredDataSource()),
 			PathInventory: []string{"/ldap/creds/{role}"},
 		},
+		"vault_secrets_sync_status": {
+			Resource:      UpdateSchemaResource(secretsSyncStatusDataSource()),
+			PathInventory: []string{"/sys/sync/destinations/{type}/{name}/associations"},
+		},
 		"vault_namespace": {
 			Resource:       UpdateSchemaResource(namespaceDataSource()),
 			PathInventory:  []string{"/sys/namespaces/{path}"},
@@ -835,6 +839,10 @@ var (
 			Resource:      UpdateSchemaResource(vercelSecretsSyncDestinationResource()),
 			PathInventory: []string{"/sys/sync/destinations/vercel-project/{name}"},
 		},
+		"vault_secrets_sync_tfc_destination": {
+			Resource:      UpdateSchemaResource(tfcSecretsSyncDestinationResource()),
+			PathInventory: []string{"/sys/sync/destinations/tfc/{name}"},
+		},
 		"vault_secrets_sync_association": {
 			Resource:      UpdateSchemaResource(secretsSyncAssociationResource()),
 			PathInventory: []string{"/sys/sync/destinations/{type}/{name}/associations/set"},
@@ -858,6 +866,7 @@ var (
 
 func UpdateSchemaResource(r *schema.Resource) *schema.Resource {
 	provider.MustAddSchema(r, provider.GetNamespaceSchema())
+	provider.MustAddSchema(r, provider.GetClusterSchema())
 
 	return r
 }
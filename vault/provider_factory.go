@@ -19,7 +19,7 @@ import (
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
 	primary := schema.NewProvider(Provider())
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		newMoveStateProviderServer(primary),
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/schema"
+)
+
+// moveStateFunc moves the state of a resource of another type to the SDKv2
+// resource r it is registered for. It is the SDKv2 equivalent of the
+// MoveState method of the Plugin Framework's resource.ResourceWithMoveState.
+// It must return a response without a target state if it does not support
+// the source resource.
+type moveStateFunc func(ctx context.Context, r *sdkschema.Resource, meta interface{}, req *tfprotov5.MoveResourceStateRequest) *tfprotov5.MoveResourceStateResponse
+
+// moveStateFuncs maps the target resource type to its moveStateFunc.
+var moveStateFuncs = map[string]moveStateFunc{
+	"vault_kv_secret_v2": kvSecretV2MoveState,
+}
+
+// moveStateProviderServer adds support for moving resource state to the SDKv2
+// provider server, which always rejects the MoveResourceState RPC.
+type moveStateProviderServer struct {
+	tfprotov5.ProviderServer
+
+	provider *schema.Provider
+}
+
+func newMoveStateProviderServer(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &moveStateProviderServer{
+			ProviderServer: p.GRPCProvider(),
+			provider:       p,
+		}
+	}
+}
+
+// MoveResourceState calls the moveStateFunc of the target resource, if any.
+func (s *moveStateProviderServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	f, ok := moveStateFuncs[req.TargetTypeName]
+	r, exists := s.provider.SchemaProvider().ResourcesMap[req.TargetTypeName]
+	if !ok || !exists {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+
+	resp := f(ctx, r, s.provider.Meta(), req)
+	if resp.TargetState == nil && len(resp.Diagnostics) == 0 {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Unable to Move Resource State",
+			Detail: "The target resource type does not support moving state from the source resource type " +
+				req.SourceTypeName + " of provider " + req.SourceProviderAddress + ".",
+		})
+	}
+
+	return resp, nil
+}
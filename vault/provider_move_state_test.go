@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-provider-vault/schema"
+)
+
+func TestMoveStateProviderServer_MoveResourceState(t *testing.T) {
+	server := newMoveStateProviderServer(schema.NewProvider(Provider()))()
+
+	tests := map[string]struct {
+		req         *tfprotov5.MoveResourceStateRequest
+		wantSummary string
+	}{
+		"unsupported source": {
+			req: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/vault",
+				SourceTypeName:        "vault_generic_secret",
+				TargetTypeName:        "vault_kv_secret_v2",
+			},
+			wantSummary: "Unable to Move Resource State",
+		},
+		"other provider": {
+			req: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/random",
+				SourceTypeName:        "vault_kv_secret",
+				TargetTypeName:        "vault_kv_secret_v2",
+			},
+			wantSummary: "Unable to Move Resource State",
+		},
+		"unsupported target": {
+			req: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/vault",
+				SourceTypeName:        "vault_kv_secret",
+				TargetTypeName:        "vault_generic_secret",
+			},
+			wantSummary: "Move Resource State Not Supported",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := server.MoveResourceState(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resp.TargetState != nil {
+				t.Fatalf("expected no target state, got %v", resp.TargetState)
+			}
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(resp.Diagnostics))
+			}
+			if got := resp.Diagnostics[0].Summary; got != tt.wantSummary {
+				t.Fatalf("expected summary %q, got %q", tt.wantSummary, got)
+			}
+		})
+	}
+}
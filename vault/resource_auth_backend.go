@@ -26,7 +26,12 @@ func AuthBackendResource() *schema.Resource {
 		ReadContext:   provider.ReadContextWrapper(authBackendRead),
 		UpdateContext: authBackendUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
+		},
+		Identity: provider.NewIdentity(),
+		// The ID changes when the backend is remounted.
+		ResourceBehavior: schema.ResourceBehavior{
+			MutableIdentity: true,
 		},
 		MigrateState:  resourceAuthBackendMigrateState,
 		CustomizeDiff: getMountCustomizeDiffFunc(consts.FieldPath),
@@ -142,6 +147,9 @@ func authBackendRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	path := d.Id()
+	if err := provider.SetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	mount, err := mountutil.GetAuthMount(ctx, client, path)
 	if err != nil {
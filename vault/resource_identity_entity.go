@@ -25,8 +25,9 @@ func identityEntityResource() *schema.Resource {
 		Delete: identityEntityDelete,
 		Exists: identityEntityExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
 		},
+		Identity: provider.NewIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -182,9 +183,13 @@ func identityEntityRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	id := d.Id()
+	if err := provider.SetIdentity(d); err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Read IdentityEntity %s", id)
-	resp, err := readIdentityEntity(client, id, d.IsNewResource())
+	resp, err := readIdentityEntity(client, id, d.IsNewResource(),
+		entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 	if err != nil {
 		// We need to check if the secret_id has expired
 		if util.IsExpiredTokenErr(err) {
@@ -273,9 +278,9 @@ func readIdentityEntityPolicies(client *api.Client, entityID string) ([]interfac
 	return make([]interface{}, 0), nil
 }
 
-func readIdentityEntity(client *api.Client, entityID string, retry bool) (*api.Secret, error) {
+func readIdentityEntity(client *api.Client, entityID string, retry bool, options ...func(client *api.Client)) (*api.Secret, error) {
 	path := entity.JoinEntityID(entityID)
 	log.Printf("[DEBUG] Reading Entity %q from %q", entityID, path)
 
-	return entity.ReadEntity(client, path, retry)
+	return entity.ReadEntity(client, path, retry, options...)
 }
@@ -204,7 +204,8 @@ func identityEntityAliasRead(ctx context.Context, d *schema.ResourceData, meta i
 	diags := diag.Diagnostics{}
 
 	log.Printf("[DEBUG] Reading entity alias %q from %q", id, path)
-	resp, err := entity.ReadEntity(client, path, d.IsNewResource())
+	resp, err := entity.ReadEntity(client, path, d.IsNewResource(),
+		entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 	if err != nil {
 		if group.IsIdentityNotFoundError(err) {
 			log.Printf("[WARN] entity alias %q not found, removing from state", id)
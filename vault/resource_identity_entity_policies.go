@@ -111,7 +111,8 @@ func identityEntityPoliciesRead(d *schema.ResourceData, meta interface{}) error
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read IdentityEntityPolicies %s", id)
-	resp, err := readIdentityEntity(client, id, d.IsNewResource())
+	resp, err := readIdentityEntity(client, id, d.IsNewResource(),
+		entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 	if err != nil {
 		if group.IsIdentityNotFoundError(err) {
 			log.Printf("[WARN] IdentityEntityPolicies %q not found, removing from state", id)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
 	"github.com/hashicorp/terraform-provider-vault/internal/identity/group"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -29,8 +30,9 @@ func identityGroupResource() *schema.Resource {
 		Read:   provider.ReadWrapper(identityGroupRead),
 		Delete: identityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
 		},
+		Identity: provider.NewIdentity(),
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -262,9 +264,13 @@ func identityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	id := d.Id()
+	if err := provider.SetIdentity(d); err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Read IdentityGroup %s", id)
-	resp, err := group.ReadIdentityGroup(client, id, d.IsNewResource())
+	resp, err := group.ReadIdentityGroup(client, id, d.IsNewResource(),
+		entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 	if err != nil {
 		// We need to check if the secret_id has expired
 		if util.IsExpiredTokenErr(err) {
@@ -316,8 +322,8 @@ func identityGroupNamePath(name string) string {
 	return fmt.Sprintf("%s/name/%s", group.IdentityGroupPath, name)
 }
 
-func readIdentityGroupPolicies(client *api.Client, groupID string, retry bool) ([]interface{}, error) {
-	resp, err := group.ReadIdentityGroup(client, groupID, retry)
+func readIdentityGroupPolicies(client *api.Client, groupID string, retry bool, options ...func(client *api.Client)) ([]interface{}, error) {
+	resp, err := group.ReadIdentityGroup(client, groupID, retry, options...)
 	if err != nil {
 		return nil, err
 	}
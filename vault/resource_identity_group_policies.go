@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
 	"github.com/hashicorp/terraform-provider-vault/internal/identity/group"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -73,7 +74,8 @@ func identityGroupPoliciesUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.Get("exclusive").(bool) {
 		data["policies"] = policies
 	} else {
-		apiPolicies, err := readIdentityGroupPolicies(client, id, d.IsNewResource())
+		apiPolicies, err := readIdentityGroupPolicies(client, id, d.IsNewResource(),
+			entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 		if err != nil {
 			return err
 		}
@@ -110,7 +112,8 @@ func identityGroupPoliciesRead(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read IdentityGroupPolicies %s", id)
-	resp, err := group.ReadIdentityGroup(client, id, d.IsNewResource())
+	resp, err := group.ReadIdentityGroup(client, id, d.IsNewResource(),
+		entity.WithMaxRetries(provider.GetMaxHTTPRetriesCCC(d, meta)))
 	if err != nil {
 		if group.IsIdentityNotFoundError(err) {
 			log.Printf("[WARN] IdentityGroupPolicies %q not found, removing from state", id)
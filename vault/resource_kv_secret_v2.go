@@ -53,8 +53,9 @@ func kvSecretV2Resource(name string) *schema.Resource {
 		DeleteContext: kvSecretV2Delete,
 		ReadContext:   provider.ReadContextWrapper(kvSecretV2Read),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
 		},
+		Identity:      provider.NewIdentity(),
 		CustomizeDiff: kvSecretV2DisableReadDiff,
 
 		Schema: map[string]*schema.Schema{
@@ -267,6 +268,10 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
+	if err := provider.SetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set(consts.FieldPath, path); err != nil {
 		return diag.FromErr(err)
 	}
@@ -304,6 +309,13 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 			return diag.Errorf("error reading from Vault: %s", err)
 		}
 		if secret == nil {
+			// The secret was moved from a vault_kv_secret, and its KV-V1
+			// mount is upgraded to KV-V2 in the same apply.
+			if mountPath, version, err := kvPreflightVersionRequest(client, path); err == nil && mountPath != "" && version == 1 {
+				log.Printf("[WARN] mount of secret (%s) is not upgraded to KV-V2 yet, keeping state", path)
+				return nil
+			}
+
 			log.Printf("[WARN] secret (%s) not found, removing from state", path)
 			d.SetId("")
 			return nil
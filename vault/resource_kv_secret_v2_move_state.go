@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// kvSecretV2MoveState moves the state of a vault_kv_secret to a
+// vault_kv_secret_v2, when the KV-V1 mount of the secret is upgraded to KV-V2
+// in place, with a configuration like:
+//
+//	moved {
+//	  from = vault_kv_secret.secret
+//	  to   = vault_kv_secret_v2.secret
+//	}
+func kvSecretV2MoveState(_ context.Context, r *schema.Resource, meta interface{}, req *tfprotov5.MoveResourceStateRequest) *tfprotov5.MoveResourceStateResponse {
+	resp := &tfprotov5.MoveResourceStateResponse{}
+
+	if req.SourceTypeName != "vault_kv_secret" || !strings.HasSuffix(req.SourceProviderAddress, "hashicorp/vault") {
+		return resp
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic("the source state is empty"))
+		return resp
+	}
+
+	var source map[string]interface{}
+	if err := json.Unmarshal(req.SourceState.JSON, &source); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+
+	sourceState := &terraform.InstanceState{
+		Attributes: map[string]string{},
+	}
+	for _, k := range []string{consts.FieldPath, consts.FieldDataJSON, consts.FieldNamespace, consts.FieldCluster} {
+		if v, ok := source[k].(string); ok {
+			sourceState.Attributes[k] = v
+		}
+	}
+
+	client, err := provider.GetClient(sourceState, meta)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+
+	// The mount is usually upgraded in the same apply, so it may still be a
+	// KV-V1 mount at plan time.
+	path := sourceState.Attributes[consts.FieldPath]
+	mountPath, _, err := kvPreflightVersionRequest(client, path)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+	if mountPath == "" {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(
+			fmt.Sprintf("unable to determine the mount of %q", path)))
+		return resp
+	}
+
+	mount := strings.TrimSuffix(mountPath, "/")
+	name := strings.TrimPrefix(path, mountPath)
+	id := getKVV2Path(mount, name, consts.FieldData)
+
+	log.Printf("[DEBUG] Moving vault_kv_secret %q to vault_kv_secret_v2 %q", path, id)
+
+	targetState := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			consts.FieldID:                id,
+			consts.FieldPath:              id,
+			consts.FieldMount:             mount,
+			consts.FieldName:              name,
+			consts.FieldDataJSON:          sourceState.Attributes[consts.FieldDataJSON],
+			consts.FieldDisableRead:       "false",
+			consts.FieldDeleteAllVersions: "false",
+		},
+	}
+	for _, k := range []string{consts.FieldNamespace, consts.FieldCluster} {
+		if v := sourceState.Attributes[k]; v != "" {
+			targetState.Attributes[k] = v
+		}
+	}
+
+	d := r.Data(targetState)
+
+	state, err := d.TfTypeResourceState()
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+	targetValue, err := tfprotov5.NewDynamicValue(state.Type(), *state)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+	resp.TargetState = &targetValue
+
+	if err := provider.SetIdentity(d); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+	identityValue, err := tfprotov5.NewDynamicValue(identity.Type(), *identity)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateErrorDiagnostic(err.Error()))
+		return resp
+	}
+	resp.TargetIdentity = &tfprotov5.ResourceIdentityData{
+		IdentityData: &identityValue,
+	}
+
+	return resp
+}
+
+func moveStateErrorDiagnostic(detail string) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Unable to Move Resource State",
+		Detail:   detail,
+	}
+}
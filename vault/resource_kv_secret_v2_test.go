@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
@@ -314,6 +315,69 @@ func TestAccKVSecretV2_WriteOnlyMigration(t *testing.T) {
 	})
 }
 
+func TestAccKVSecretV2_moveFromKVSecret(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "1" }
+}
+
+resource "vault_kv_secret" "test" {
+  path      = "${vault_mount.kv.path}/%s"
+  data_json = jsonencode({ foo = "bar" })
+}
+`, mount, name),
+			},
+			{
+				// upgrade the mount and move the secret in the same apply
+				Config: fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+moved {
+  from = vault_kv_secret.test
+  to   = vault_kv_secret_v2.test
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kv.path
+  name      = "%s"
+  data_json = jsonencode({ foo = "bar" })
+}
+`, mount, name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, fmt.Sprintf("%s/data/%s", mount, name)),
+					resource.TestCheckResourceAttr("vault_mount.kv", "options.version", "2"),
+					assertKVDataEquals(mount, name, map[string]interface{}{"foo": "bar"}),
+				),
+			},
+		},
+	})
+}
+
 func readKVData(t *testing.T, mount, name string) {
 	t.Helper()
 	client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
@@ -8,17 +8,24 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util/mountutil"
 )
 
+// kvUpgradeMessage is part of the error returned by a KV mount while its data
+// is being upgraded from KV-V1 to KV-V2.
+const kvUpgradeMessage = "Upgrading from non-versioned to versioned data"
+
 type schemaMap map[string]*schema.Schema
 
 func getMountSchema(excludes ...string) schemaMap {
@@ -189,7 +196,12 @@ func MountResource() *schema.Resource {
 		DeleteContext: mountDelete,
 		ReadContext:   provider.ReadContextWrapper(mountRead),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
+		},
+		Identity: provider.NewIdentity(),
+		// The ID changes when the backend is remounted.
+		ResourceBehavior: schema.ResourceBehavior{
+			MutableIdentity: true,
 		},
 		Schema: getMountSchema(),
 	}
@@ -377,9 +389,56 @@ func updateMount(ctx context.Context, d *schema.ResourceData, meta interface{},
 		break
 	}
 
+	if !excludeType && isKVUpgrade(d) {
+		if err := waitForKVUpgrade(ctx, client, path); err != nil {
+			return err
+		}
+	}
+
 	return readMount(ctx, d, meta, excludeType, skipRemount)
 }
 
+// isKVUpgrade returns true if the update changes the version of a KV mount
+// from 1 to 2, which makes Vault upgrade the data of the mount in place.
+func isKVUpgrade(d *schema.ResourceData) bool {
+	if d.Get(consts.FieldType).(string) != "kv" || !d.HasChange(consts.FieldOptions) {
+		return false
+	}
+
+	o, n := d.GetChange(consts.FieldOptions)
+	oldVersion, _ := o.(map[string]interface{})["version"].(string)
+	newVersion, _ := n.(map[string]interface{})["version"].(string)
+
+	return oldVersion != "2" && newVersion == "2"
+}
+
+// waitForKVUpgrade waits until the in place upgrade of a KV mount from KV-V1
+// to KV-V2 is done. The mount rejects all requests while it is upgrading.
+func waitForKVUpgrade(ctx context.Context, client *api.Client, path string) error {
+	log.Printf("[DEBUG] Waiting for the upgrade of KV mount %s to KV-V2", path)
+
+	client, err := helper.DisableReadCache(client)
+	if err != nil {
+		return err
+	}
+
+	bo := backoff.WithContext(backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 600), ctx)
+	err = backoff.RetryNotify(func() error {
+		_, err := client.Logical().ReadWithContext(ctx, path+"/config")
+		if err != nil && !strings.Contains(err.Error(), kvUpgradeMessage) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, bo, func(err error, duration time.Duration) {
+		log.Printf("[WARN] KV mount %q is still upgrading, retrying in %s", path, duration)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for the upgrade of KV mount %q: %s", path, err)
+	}
+
+	return nil
+}
+
 func mountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := provider.GetClient(d, meta)
 	if err != nil {
@@ -398,6 +457,10 @@ func mountDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 }
 
 func mountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := provider.SetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	err := readMount(ctx, d, meta, false, false)
 	if err != nil {
 		return diag.FromErr(err)
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -154,6 +155,10 @@ func namespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	// wait for the namespace to be gone...
+	client, e = helper.DisableReadCache(client)
+	if e != nil {
+		return diag.FromErr(e)
+	}
 	return diag.FromErr(backoff.RetryNotify(func() error {
 		if resp, _ := client.Logical().Read(consts.SysNamespaceRoot + path); resp != nil {
 			return fmt.Errorf("namespace %q still exists", path)
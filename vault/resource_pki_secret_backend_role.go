@@ -85,8 +85,9 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 		UpdateContext: pkiSecretBackendRoleUpdate,
 		DeleteContext: pkiSecretBackendRoleDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
 		},
+		Identity: provider.NewIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			consts.FieldBackend: {
@@ -601,6 +602,10 @@ func pkiSecretBackendRoleRead(_ context.Context, d *schema.ResourceData, meta in
 	}
 
 	path := d.Id()
+	if err := provider.SetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	backend, err := pkiSecretBackendRoleBackendFromPath(path)
 	if err != nil {
 		log.Printf("[WARN] Removing role %q because its ID is invalid", path)
@@ -20,8 +20,9 @@ func policyResource() *schema.Resource {
 		Delete: policyDelete,
 		Read:   provider.ReadWrapper(policyRead),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: provider.ImportStatePassthroughWithIdentity,
 		},
+		Identity: provider.NewIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -119,6 +120,9 @@ func policyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	name := d.Id()
+	if err := provider.SetIdentity(d); err != nil {
+		return err
+	}
 
 	policy, err := client.Sys().GetPolicy(name)
 	if err != nil {
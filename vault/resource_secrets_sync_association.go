@@ -9,11 +9,17 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
@@ -23,15 +29,21 @@ import (
 var syncAssociationFieldsFromIDRegex = regexp.MustCompile("^(.+)/dest/(.+)/mount/(.+)/secret/(.+)$")
 
 const (
-	fieldSecretName = "secret_name"
-	fieldSyncStatus = "sync_status"
-	fieldUpdatedAt  = "updated_at"
-	fieldSubkey     = "sub_key"
+	fieldSecretName           = "secret_name"
+	fieldSyncStatus           = "sync_status"
+	fieldUpdatedAt            = "updated_at"
+	fieldSubkey               = "sub_key"
+	fieldWaitForSynced        = "wait_for_synced"
+	fieldWaitForSyncedTimeout = "wait_for_synced_timeout"
+
+	syncStatusSynced            = "SYNCED"
+	defaultWaitForSyncedTimeout = 300
 )
 
 func secretsSyncAssociationResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: provider.MountCreateContextWrapper(secretsSyncAssociationWrite, provider.VaultVersion116),
+		UpdateContext: secretsSyncAssociationUpdate,
 		ReadContext:   provider.ReadContextWrapper(secretsSyncAssociationRead),
 		DeleteContext: secretsSyncAssociationDelete,
 		Importer: &schema.ResourceImporter{
@@ -63,6 +75,31 @@ func secretsSyncAssociationResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Specifies the name of the secret to synchronize.",
 			},
+			fieldWaitForSynced: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Wait on creation until the destination reports the secret as synced. " +
+					"Creation fails if the sync fails.",
+			},
+			fieldWaitForSyncedTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultWaitForSyncedTimeout,
+				Description:  "Maximum time in seconds to wait for the secret to be synced.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			fieldSyncStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Sync status of the association. If the subkeys of the secret " +
+					"have different statuses, the status of the least healthy subkey.",
+			},
+			fieldUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last update of the association.",
+			},
 			consts.FieldMetadata: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -122,9 +159,48 @@ func secretsSyncAssociationWrite(ctx context.Context, d *schema.ResourceData, me
 	id := fmt.Sprintf("%s/dest/%s/mount/%s/secret/%s", destType, name, mount, secretName)
 	d.SetId(id)
 
+	if d.Get(fieldWaitForSynced).(bool) {
+		if diags := secretsSyncAssociationWait(ctx, d, meta); diags != nil {
+			return diags
+		}
+	}
+
+	return secretsSyncAssociationRead(ctx, d, meta)
+}
+
+// secretsSyncAssociationUpdate only updates the wait options, it waits for the
+// secret to be synced when the wait is enabled.
+func secretsSyncAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get(fieldWaitForSynced).(bool) && d.HasChange(fieldWaitForSynced) {
+		if diags := secretsSyncAssociationWait(ctx, d, meta); diags != nil {
+			return diags
+		}
+	}
+
 	return secretsSyncAssociationRead(ctx, d, meta)
 }
 
+func secretsSyncAssociationWait(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	accessor, err := getMountAccessor(ctx, d, meta, d.Get(consts.FieldMount).(string))
+	if err != nil {
+		return diag.Errorf("could not obtain accessor from given mount; err=%s", err)
+	}
+
+	timeout := time.Duration(d.Get(fieldWaitForSyncedTimeout).(int)) * time.Second
+	err = waitForSyncAssociation(ctx, client, d.Get(consts.FieldName).(string), d.Get(consts.FieldType).(string),
+		accessor, d.Get(fieldSecretName).(string), timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
 func secretsSyncAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -158,44 +234,91 @@ func secretsSyncAssociationRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
+	// the wait options are only known by Terraform, set their defaults on import
+	if _, ok := d.GetOk(fieldWaitForSyncedTimeout); !ok {
+		if err := d.Set(fieldWaitForSynced, false); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(fieldWaitForSyncedTimeout, defaultWaitForSyncedTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	accessor, err := getMountAccessor(ctx, d, meta, mount)
 	if err != nil {
 		return diag.Errorf("could not obtain accessor from given mount; err=%s", err)
 	}
 	// List all associations for secret destination
-	resp, err := client.Logical().ReadWithContext(ctx, fmt.Sprintf("%s/%s", syncutil.SecretsSyncDestinationPath(destName, typ), "associations"))
-	if err != nil {
-		return diag.Errorf("error reading associations for destination %s of type %s", destName, typ)
-	}
-
-	model, err := getSyncAssociationModelFromResponse(resp)
+	model, err := readSyncAssociations(ctx, client, destName, typ)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	metadata := make([]map[string]interface{}, 0)
-	for _, v := range model.AssociatedSecrets {
-		if v.SecretName == secretName && v.Accessor == accessor {
-			m := map[string]interface{}{
-				fieldSubkey:     v.Subkey,
-				fieldSyncStatus: v.SyncStatus,
-				fieldUpdatedAt:  v.UpdatedAt,
-			}
-
-			metadata = append(metadata, m)
-		}
-	}
-
-	if len(metadata) == 0 {
+	associations := model.filter(accessor, secretName)
+	if len(associations) == 0 {
 		log.Printf("[WARN] no associated secrets found for given mount accessor and secret name %s/%s, removing from state", accessor, secretName)
 		d.SetId("")
 		return nil
 	}
 
+	metadata := make([]map[string]interface{}, 0, len(associations))
+	for _, v := range associations {
+		m := map[string]interface{}{
+			fieldSubkey:     v.Subkey,
+			fieldSyncStatus: v.SyncStatus,
+			fieldUpdatedAt:  v.UpdatedAt,
+		}
+
+		metadata = append(metadata, m)
+	}
+
 	if err := d.Set(consts.FieldMetadata, metadata); err != nil {
 		return diag.FromErr(err)
 	}
 
+	status, updatedAt := syncAssociationsStatus(associations)
+	if err := d.Set(fieldSyncStatus, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(fieldUpdatedAt, updatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// waitForSyncAssociation waits until the destination reports all the subkeys
+// of the associated secret as synced, or one of them as failed.
+func waitForSyncAssociation(ctx context.Context, client *api.Client, destName, typ, accessor, secretName string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for secret %s/%s to be synced to destination %s of type %s", accessor, secretName, destName, typ)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	bo := backoff.WithContext(backoff.NewConstantBackOff(time.Second), ctx)
+	err := backoff.RetryNotify(func() error {
+		model, err := readSyncAssociations(ctx, client, destName, typ)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		status, _ := syncAssociationsStatus(model.filter(accessor, secretName))
+		switch {
+		case status == syncStatusSynced:
+			return nil
+		case isSyncStatusFailed(status):
+			return backoff.Permanent(fmt.Errorf("sync failed with status %s", status))
+		default:
+			return fmt.Errorf("sync status is %q", status)
+		}
+	}, bo, func(err error, duration time.Duration) {
+		log.Printf("[DEBUG] Secret %s/%s is not synced yet (%s), retrying in %s", accessor, secretName, err, duration)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for secret %q to be synced to destination %q: %s", secretName, destName, err)
+	}
+
 	return nil
 }
 
@@ -272,6 +395,81 @@ type syncAssociationData struct {
 	Subkey     string `json:"sub_key"`
 }
 
+// filter returns the associations of the given mount accessor and secret,
+// sorted by secret and subkey. Empty values match all the associations.
+func (m *syncAssociationModel) filter(accessor, secretName string) []syncAssociationData {
+	var res []syncAssociationData
+	for _, v := range m.AssociatedSecrets {
+		if (accessor == "" || v.Accessor == accessor) && (secretName == "" || v.SecretName == secretName) {
+			res = append(res, v)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Accessor != res[j].Accessor {
+			return res[i].Accessor < res[j].Accessor
+		}
+		if res[i].SecretName != res[j].SecretName {
+			return res[i].SecretName < res[j].SecretName
+		}
+		return res[i].Subkey < res[j].Subkey
+	})
+
+	return res
+}
+
+// syncAssociationsStatus returns the status of the least healthy of the
+// associations, failed ones first, and the timestamp of the latest update.
+func syncAssociationsStatus(associations []syncAssociationData) (string, string) {
+	var status, updatedAt string
+	var latest time.Time
+	for _, v := range associations {
+		switch {
+		case status == "",
+			isSyncStatusFailed(v.SyncStatus) && !isSyncStatusFailed(status),
+			status == syncStatusSynced && v.SyncStatus != syncStatusSynced:
+			status = v.SyncStatus
+		}
+
+		if t, err := time.Parse(time.RFC3339Nano, v.UpdatedAt); err == nil {
+			if t.After(latest) {
+				latest = t
+				updatedAt = v.UpdatedAt
+			}
+		} else if updatedAt == "" {
+			updatedAt = v.UpdatedAt
+		}
+	}
+
+	return status, updatedAt
+}
+
+// isSyncStatusFailed returns true if the status is one of the error statuses
+// of Vault, e.g. EXTERNAL_SERVICE_ERROR.
+func isSyncStatusFailed(status string) bool {
+	return strings.HasSuffix(status, "_ERROR")
+}
+
+// readSyncAssociations reads the associations of the destination. Their sync
+// status is polled for changes by waitForSyncAssociation, and changes after
+// every write of the associated secrets, so they are never cached.
+func readSyncAssociations(ctx context.Context, client *api.Client, destName, typ string) (*syncAssociationModel, error) {
+	client, err := helper.DisableReadCache(client)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Logical().ReadWithContext(ctx, fmt.Sprintf("%s/%s", syncutil.SecretsSyncDestinationPath(destName, typ), "associations"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading associations for destination %s of type %s: %s", destName, typ, err)
+	}
+	if resp == nil {
+		return &syncAssociationModel{}, nil
+	}
+
+	return getSyncAssociationModelFromResponse(resp)
+}
+
 func getSyncAssociationModelFromResponse(resp *api.Secret) (*syncAssociationModel, error) {
 	// convert resp data to JSON
 	b, err := json.Marshal(resp.Data)
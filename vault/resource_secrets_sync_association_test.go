@@ -5,10 +5,15 @@ package vault
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -50,14 +55,129 @@ func TestSecretsSyncAssociation_gh(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "metadata.0.sub_key", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.sync_status"),
 					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.updated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, fieldSyncStatus),
+					resource.TestCheckResourceAttrSet(resourceName, fieldUpdatedAt),
+					resource.TestCheckResourceAttr(resourceName, fieldWaitForSynced, "false"),
 				),
 			},
-			testutil.GetImportTestStep(resourceName, false, nil),
+			{
+				Config: testSecretsSyncAssociationConfig_ghWaitForSynced(mount, accessToken, repoOwner, repoName, destName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fieldWaitForSynced, "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldSyncStatus, syncStatusSynced),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.sync_status", syncStatusSynced),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, fieldWaitForSynced, fieldWaitForSyncedTimeout),
 		},
 	})
 }
 
+func TestSyncAssociationsStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		associations  []syncAssociationData
+		wantStatus    string
+		wantUpdatedAt string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "synced",
+			associations: []syncAssociationData{
+				{Subkey: "dev", SyncStatus: "SYNCED", UpdatedAt: "2024-01-01T10:00:00Z"},
+				{Subkey: "prod", SyncStatus: "SYNCED", UpdatedAt: "2024-01-01T11:00:00Z"},
+			},
+			wantStatus:    "SYNCED",
+			wantUpdatedAt: "2024-01-01T11:00:00Z",
+		},
+		{
+			name: "pending",
+			associations: []syncAssociationData{
+				{Subkey: "dev", SyncStatus: "SYNCED", UpdatedAt: "2024-01-01T12:00:00.5Z"},
+				{Subkey: "prod", SyncStatus: "PENDING", UpdatedAt: "2024-01-01T11:00:00Z"},
+			},
+			wantStatus:    "PENDING",
+			wantUpdatedAt: "2024-01-01T12:00:00.5Z",
+		},
+		{
+			name: "failed",
+			associations: []syncAssociationData{
+				{Subkey: "dev", SyncStatus: "PENDING", UpdatedAt: "2024-01-01T10:00:00Z"},
+				{Subkey: "prod", SyncStatus: "EXTERNAL_SERVICE_ERROR", UpdatedAt: "2024-01-01T11:00:00Z"},
+				{Subkey: "test", SyncStatus: "SYNCED", UpdatedAt: "2024-01-01T09:00:00Z"},
+			},
+			wantStatus:    "EXTERNAL_SERVICE_ERROR",
+			wantUpdatedAt: "2024-01-01T11:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, updatedAt := syncAssociationsStatus(tt.associations)
+			if status != tt.wantStatus {
+				t.Errorf("syncAssociationsStatus() status = %q, want %q", status, tt.wantStatus)
+			}
+			if updatedAt != tt.wantUpdatedAt {
+				t.Errorf("syncAssociationsStatus() updatedAt = %q, want %q", updatedAt, tt.wantUpdatedAt)
+			}
+		})
+	}
+}
+
+func TestWaitForSyncAssociation(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/sync/destinations/gh/dest/associations" {
+			http.NotFound(w, r)
+			return
+		}
+		// the status is polled, so it must bypass the read cache
+		if got := r.Header.Get("Cache-Control"); got != "no-cache" {
+			t.Errorf("expected the Cache-Control header to be %q, got %q", "no-cache", got)
+		}
+
+		status := "PENDING"
+		if calls.Add(1) > 1 {
+			status = "SYNCED"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data": {"associated_secrets": {"kv_1234/secret": {
+  "accessor": "kv_1234",
+  "secret_name": "secret",
+  "sync_status": %q,
+  "updated_at": "2024-01-01T10:00:00Z"
+}}}}`, status)
+	}))
+	defer ts.Close()
+
+	config := api.DefaultConfig()
+	config.Address = ts.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := waitForSyncAssociation(context.Background(), client, "dest", "gh", "kv_1234", "secret", 10*time.Second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 association requests, got %d", got)
+	}
+}
+
 func testSecretsSyncAssociationConfig_gh(mount, accessToken, owner, repoName, destName, secretName string) string {
+	return testSecretsSyncAssociationConfig_ghWithOptions(mount, accessToken, owner, repoName, destName, secretName, "")
+}
+
+func testSecretsSyncAssociationConfig_ghWaitForSynced(mount, accessToken, owner, repoName, destName, secretName string) string {
+	return testSecretsSyncAssociationConfig_ghWithOptions(mount, accessToken, owner, repoName, destName, secretName, `
+  wait_for_synced         = true
+  wait_for_synced_timeout = 120`)
+}
+
+func testSecretsSyncAssociationConfig_ghWithOptions(mount, accessToken, owner, repoName, destName, secretName, options string) string {
 	ret := fmt.Sprintf(`
 resource "vault_mount" "test" {
  path        = "%s"
@@ -89,7 +209,8 @@ resource "vault_secrets_sync_association" "test" {
   type        = vault_secrets_sync_gh_destination.test.type
   mount       = vault_mount.test.path
   secret_name = vault_kv_secret_v2.test.name
-}`, mount, secretName, destName, accessToken, owner, repoName)
+  %s
+}`, mount, secretName, destName, accessToken, owner, repoName, options)
 
 	return ret
 }
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
+)
+
+const (
+	fieldWorkspaceID = "workspace_id"
+	fieldVarsetID    = "varset_id"
+	tfcSyncType      = "tfc"
+)
+
+var tfcSyncWriteFields = []string{
+	consts.FieldToken,
+	consts.FieldAddress,
+	consts.FieldOrganization,
+	consts.FieldProject,
+	fieldWorkspaceID,
+	fieldVarsetID,
+	consts.FieldGranularity,
+	consts.FieldSecretNameTemplate,
+}
+
+var tfcSyncReadFields = []string{
+	consts.FieldAddress,
+	consts.FieldOrganization,
+	consts.FieldProject,
+	fieldWorkspaceID,
+	fieldVarsetID,
+	consts.FieldGranularity,
+	consts.FieldSecretNameTemplate,
+}
+
+// These fields are conditionally added to read and write operations when Vault 1.19+ is detected
+var tfcSyncFieldsV119 = []string{
+	consts.FieldAllowedIPv4Addresses,
+	consts.FieldAllowedIPv6Addresses,
+	consts.FieldAllowedPorts,
+	consts.FieldDisableStrictNetworking,
+}
+
+// Fields that need TypeSet to List conversion for JSON serialization
+var tfcTypeSetFields = map[string]bool{
+	consts.FieldAllowedIPv4Addresses: true,
+	consts.FieldAllowedIPv6Addresses: true,
+	consts.FieldAllowedPorts:         true,
+}
+
+func tfcSecretsSyncDestinationResource() *schema.Resource {
+	return provider.MustAddSecretsSyncCommonSchema(&schema.Resource{
+		CreateContext: provider.MountCreateContextWrapper(tfcSecretsSyncDestinationCreateUpdate, provider.VaultVersion116),
+		UpdateContext: tfcSecretsSyncDestinationCreateUpdate,
+		ReadContext:   provider.ReadContextWrapper(tfcSecretsSyncDestinationRead),
+		DeleteContext: tfcSecretsSyncDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the HCP Terraform destination.",
+				ForceNew:    true,
+			},
+			consts.FieldTokenWO: {
+				Type:      schema.TypeString,
+				Required:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Description: "HCP Terraform API token with the permissions to manage the variables " +
+					"of the workspace or variable set. This is a write-only field and will not be " +
+					"read back from Vault.",
+			},
+			consts.FieldTokenWOVersion: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "A version counter for the write-only token_wo field. " +
+					"Incrementing this value will trigger an update of the token.",
+			},
+			consts.FieldAddress: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address of the HCP Terraform or Terraform Enterprise instance. Defaults to https://app.terraform.io.",
+			},
+			consts.FieldOrganization: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the organization that owns the workspace or variable set.",
+				ForceNew:    true,
+			},
+			consts.FieldProject: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the project of the workspace or variable set.",
+				ForceNew:    true,
+			},
+			fieldWorkspaceID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "ID of the workspace where to manage variables.",
+				ForceNew:     true,
+				ExactlyOneOf: []string{fieldWorkspaceID, fieldVarsetID},
+			},
+			fieldVarsetID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "ID of the variable set where to manage variables.",
+				ForceNew:     true,
+				ExactlyOneOf: []string{fieldWorkspaceID, fieldVarsetID},
+			},
+			consts.FieldAllowedIPv4Addresses: {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "Set of allowed IPv4 addresses in CIDR notation (e.g., 192.168.1.1/32) " +
+					"for outbound connections from Vault to the destination. If not set, all IPv4 addresses are allowed.",
+			},
+			consts.FieldAllowedIPv6Addresses: {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "Set of allowed IPv6 addresses in CIDR notation (e.g., 2001:db8::1/128) " +
+					"for outbound connections from Vault to the destination. If not set, all IPv6 addresses are allowed.",
+			},
+			consts.FieldAllowedPorts: {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Optional: true,
+				Description: "Set of allowed ports for outbound connections from Vault to the destination. " +
+					"If not set, all ports are allowed.",
+			},
+			consts.FieldDisableStrictNetworking: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set to true, disables strict networking enforcement for this destination. " +
+					"When disabled, Vault will not enforce allowed IP addresses and ports.",
+			},
+		},
+	})
+}
+
+func tfcSecretsSyncDestinationCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	writeFields := make([]string, len(tfcSyncWriteFields))
+	copy(writeFields, tfcSyncWriteFields)
+	readFields := make([]string, len(tfcSyncReadFields))
+	copy(readFields, tfcSyncReadFields)
+
+	// Add Vault 1.19+ fields if supported
+	if provider.IsAPISupported(meta, provider.VaultVersion119) {
+		writeFields = append(writeFields, tfcSyncFieldsV119...)
+		readFields = append(readFields, tfcSyncFieldsV119...)
+	}
+
+	return syncutil.SyncDestinationCreateUpdateWithOptions(ctx, d, meta, tfcSyncType, writeFields, readFields, tfcTypeSetFields)
+}
+
+func tfcSecretsSyncDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	readFields := make([]string, len(tfcSyncReadFields))
+	copy(readFields, tfcSyncReadFields)
+
+	// Add Vault 1.19+ fields if supported
+	if provider.IsAPISupported(meta, provider.VaultVersion119) {
+		readFields = append(readFields, tfcSyncFieldsV119...)
+	}
+
+	return syncutil.SyncDestinationRead(ctx, d, meta, tfcSyncType, readFields, map[string]string{
+		consts.FieldGranularity: consts.FieldGranularityLevel,
+	})
+}
+
+func tfcSecretsSyncDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return syncutil.SyncDestinationDelete(ctx, d, meta, tfcSyncType)
+}
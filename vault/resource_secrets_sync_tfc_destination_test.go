@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTFCSecretsSyncDestination(t *testing.T) {
+	destName := acctest.RandomWithPrefix("tf-sync-dest-tfc")
+
+	resourceName := "vault_secrets_sync_tfc_destination.test"
+
+	values := testutil.SkipTestEnvUnset(t,
+		"TFC_TOKEN",
+		"TFC_ORGANIZATION",
+		"TFC_WORKSPACE_ID",
+	)
+	token := values[0]
+	organization := values[1]
+	workspaceID := values[2]
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			acctestutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion116)
+		},
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testTFCSecretsSyncDestinationConfig(token, organization, workspaceID, destName, defaultSecretsSyncTemplate, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, destName),
+					resource.TestCheckNoResourceAttr(resourceName, consts.FieldTokenWO),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTokenWOVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldOrganization, organization),
+					resource.TestCheckResourceAttr(resourceName, fieldWorkspaceID, workspaceID),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldAddress),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, tfcSyncType),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecretNameTemplate, defaultSecretsSyncTemplate),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldGranularity, "secret-path"),
+				),
+			},
+			{
+				Config: testTFCSecretsSyncDestinationConfig(token, organization, workspaceID, destName, secretsKeyTemplate, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, destName),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTokenWOVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldOrganization, organization),
+					resource.TestCheckResourceAttr(resourceName, fieldWorkspaceID, workspaceID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecretNameTemplate, secretsKeyTemplate),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldGranularity, "secret-key"),
+				),
+			},
+			getTFCImportTestStep(resourceName),
+		},
+	})
+}
+
+func getTFCImportTestStep(resourceName string) resource.TestStep {
+	ignoreFields := []string{consts.FieldTokenWOVersion}
+
+	// On Vault < 1.19, the V119 networking fields won't be returned from the API
+	// so we need to ignore them during import verification
+	meta := testProvider.Meta().(*provider.ProviderMeta)
+	if !meta.IsAPISupported(provider.VaultVersion119) {
+		ignoreFields = append(ignoreFields,
+			consts.FieldAllowedIPv4Addresses,
+			consts.FieldAllowedIPv6Addresses,
+			consts.FieldAllowedPorts,
+			consts.FieldDisableStrictNetworking,
+		)
+	}
+
+	return testutil.GetImportTestStep(resourceName, false, nil, ignoreFields...)
+}
+
+func testTFCSecretsSyncDestinationConfig(token, organization, workspaceID, destName, templ string, tokenVersion int, update bool) string {
+	ret := fmt.Sprintf(`
+resource "vault_secrets_sync_tfc_destination" "test" {
+  name             = "%s"
+  token_wo         = "%s"
+  token_wo_version = %d
+  organization     = "%s"
+  workspace_id     = "%s"
+  %s
+}
+`, destName, token, tokenVersion, organization, workspaceID, testSecretsSyncDestinationCommonConfig(templ, true, false, update))
+
+	return ret
+}